@@ -0,0 +1,47 @@
+package enum
+
+import (
+	"fmt"
+)
+
+// Enum is implemented by every type generated by go-enum.
+type Enum interface {
+	comparable
+	fmt.Stringer
+	Validate() error
+}
+
+// Parser is implemented by every type T generated by go-enum, its methods
+// ignore the receiver so they can be called through the zero value of T.
+type Parser[T Enum] interface {
+	Enum
+	// Values returns the string representations of the valid values.
+	Values() []string
+	// Parse parses the string representation of a value.
+	Parse(str string) (T, error)
+}
+
+// Parse parses the string representation of a T.
+func Parse[T Parser[T]](str string) (T, error) {
+	var zero T
+	return zero.Parse(str)
+}
+
+// All returns the valid values of T, in the order of declaration.
+func All[T Parser[T]]() []T {
+	var zero T
+	names := zero.Values()
+	all := make([]T, len(names))
+	for i := range names {
+		// The names are the string representations of valid values, so they
+		// always parse.
+		all[i], _ = zero.Parse(names[i])
+	}
+	return all
+}
+
+// Names returns the string representations of the valid values of T.
+func Names[T Parser[T]]() []string {
+	var zero T
+	return zero.Values()
+}
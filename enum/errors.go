@@ -0,0 +1,77 @@
+// Package enum contains the runtime types shared by the code generated by
+// go-enum.
+package enum
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalid is matched by errors.Is for every InvalidValueError.
+var ErrInvalid = errors.New("invalid enum value")
+
+// InvalidValueError is returned when parsing, validating or unmarshaling a
+// value that is not a valid value of the enum.
+type InvalidValueError struct {
+	// Enum is the name of the enum type.
+	Enum string
+	// Input is the rejected input, formatted as a string.
+	Input string
+	// Allowed lists the string representations of the valid values.
+	Allowed []string
+}
+
+func (e *InvalidValueError) Error() string {
+	return fmt.Sprintf("%s is not a valid %s", e.Input, e.Enum)
+}
+
+// Is reports whether target is ErrInvalid.
+func (e *InvalidValueError) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// ErrInvalidTransition is matched by errors.Is for every
+// InvalidTransitionError.
+var ErrInvalidTransition = errors.New("invalid enum transition")
+
+// InvalidTransitionError is returned when transitioning between values that
+// are not connected by an //enum:to option.
+type InvalidTransitionError struct {
+	// Enum is the name of the enum type.
+	Enum string
+	// From and To are the string representations of the values.
+	From string
+	To   string
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("%s can not transition from %s to %s", e.Enum, e.From, e.To)
+}
+
+// Is reports whether target is ErrInvalidTransition.
+func (e *InvalidTransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+// ErrOrdinalRange is matched by errors.Is for every OrdinalRangeError.
+var ErrOrdinalRange = errors.New("enum ordinal out of range")
+
+// OrdinalRangeError is returned for an ordinal that does not belong to a valid
+// value of the enum.
+type OrdinalRangeError struct {
+	// Enum is the name of the enum type.
+	Enum string
+	// Ordinal is the rejected ordinal.
+	Ordinal int
+	// Len is the number of valid values.
+	Len int
+}
+
+func (e *OrdinalRangeError) Error() string {
+	return fmt.Sprintf("ordinal %d of %s is out of range [0, %d)", e.Ordinal, e.Enum, e.Len)
+}
+
+// Is reports whether target is ErrOrdinalRange.
+func (e *OrdinalRangeError) Is(target error) bool {
+	return target == ErrOrdinalRange
+}
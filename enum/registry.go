@@ -0,0 +1,95 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// Definition describes an enum registered by the code generated with
+// -register.
+type Definition struct {
+	Name    string  `json:"name"`
+	Package string  `json:"package"`
+	Values  []Value `json:"values"`
+}
+
+// Value describes a declared value of an enum.
+type Value struct {
+	// Name is the string representation of the value.
+	Name string `json:"name"`
+	// Value is the underlying value.
+	Value       interface{} `json:"value"`
+	Invalid     bool        `json:"invalid,omitempty"`
+	Default     bool        `json:"default,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+var registry = struct {
+	sync.RWMutex
+	definitions map[string]Definition
+}{definitions: map[string]Definition{}}
+
+// clone copies the values of the definition, so the registry can not be
+// modified through the returned definitions.
+func (d Definition) clone() Definition {
+	if d.Values != nil {
+		d.Values = append([]Value(nil), d.Values...)
+	}
+	return d
+}
+
+func key(pkg string, name string) string {
+	return fmt.Sprint(pkg, ".", name)
+}
+
+// Register adds an enum to the registry, it panics when the enum is already
+// registered.
+func Register(definition Definition) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	k := key(definition.Package, definition.Name)
+	if _, ok := registry.definitions[k]; ok {
+		panic(fmt.Sprintf("enum: %s is already registered", k))
+	}
+	registry.definitions[k] = definition.clone()
+}
+
+// Lookup returns a copy of the registered enum with the name in the package.
+func Lookup(pkg string, name string) (Definition, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	definition, ok := registry.definitions[key(pkg, name)]
+	return definition.clone(), ok
+}
+
+// Definitions returns copies of the registered enums, sorted by package and
+// name.
+func Definitions() []Definition {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	definitions := make([]Definition, 0, len(registry.definitions))
+	for _, definition := range registry.definitions {
+		definitions = append(definitions, definition.clone())
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return key(definitions[i].Package, definitions[i].Name) < key(definitions[j].Package, definitions[j].Name)
+	})
+	return definitions
+}
+
+// Handler serves the registered enums as JSON.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Definitions()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+var arrayEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// QuoteArrayElement quotes an element of a postgres array, or of comma
+// separated values, when it is empty, NULL or contains a separator, quote,
+// brace, backslash or white space.
+func QuoteArrayElement(element string) string {
+	if element != "" && !strings.EqualFold(element, "null") && !strings.ContainsAny(element, ",\"\\{} \t\n\v\f\r") {
+		return element
+	}
+
+	return `"` + arrayEscaper.Replace(element) + `"`
+}
+
+// SplitArray splits a postgres array, or comma separated values, into its
+// elements, removing the quotes and escapes added by QuoteArrayElement.
+func SplitArray(str string) ([]string, error) {
+	if strings.HasPrefix(str, "{") && strings.HasSuffix(str, "}") {
+		str = str[1 : len(str)-1]
+	}
+	if str == "" {
+		return nil, nil
+	}
+
+	var elements []string
+	var element strings.Builder
+	quoted, escaped := false, false
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; {
+		case escaped:
+			element.WriteByte(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case c == ',' && !quoted:
+			elements = append(elements, element.String())
+			element.Reset()
+		default:
+			element.WriteByte(c)
+		}
+	}
+	if quoted || escaped {
+		return nil, fmt.Errorf("unterminated element in %q", str)
+	}
+
+	return append(elements, element.String()), nil
+}
@@ -0,0 +1,47 @@
+package enum
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestArrayElements(t *testing.T) {
+	elements := []string{"plain", "a,b", `say "hi"`, `back\slash`, "{braced}", "two words", "", "NULL"}
+
+	quoted := make([]string, len(elements))
+	for i := range elements {
+		quoted[i] = QuoteArrayElement(elements[i])
+	}
+
+	want := `plain,"a,b","say \"hi\"","back\\slash","{braced}","two words","","NULL"`
+	if joined := strings.Join(quoted, ","); joined != want {
+		t.Errorf("quoted = %s, want %s", joined, want)
+	}
+
+	for _, str := range []string{want, "{" + want + "}"} {
+		if split, err := SplitArray(str); err != nil || !reflect.DeepEqual(split, elements) {
+			t.Errorf("SplitArray(%s) = %q, %v, want %q", str, split, err, elements)
+		}
+	}
+}
+
+func TestSplitArray(t *testing.T) {
+	for str, want := range map[string][]string{
+		"":      nil,
+		"{}":    nil,
+		"1,4":   {"1", "4"},
+		"{1,4}": {"1", "4"},
+		`{"1"}`: {"1"},
+	} {
+		if split, err := SplitArray(str); err != nil || !reflect.DeepEqual(split, want) {
+			t.Errorf("SplitArray(%s) = %q, %v, want %q", str, split, err, want)
+		}
+	}
+
+	for _, str := range []string{`{"open}`, `a\`} {
+		if _, err := SplitArray(str); err == nil {
+			t.Errorf("expected an error splitting %s", str)
+		}
+	}
+}
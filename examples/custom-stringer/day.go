@@ -1,4 +1,4 @@
-//go:generate go run --mod=mod github.com/klippa-app/go-enum -no-stringer -gql=full -json -bson -xml -ent
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -no-stringer -gql=full -json -bson -xml -ent -test -atomic
 package day
 
 type Day string
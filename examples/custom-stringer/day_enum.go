@@ -3,6 +3,8 @@ package day
 
 import (
 	"fmt"
+
+	"github.com/klippa-app/go-enum/enum"
 )
 
 func AllDays() []Day {
@@ -48,7 +50,54 @@ func DayFromString(val string) (*Day, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("%s is not a valid Day", val)
+	return nil, invalidDayError(val)
+}
+
+func DayFromValue(value string) (*Day, error) {
+	valid := validDays()
+	for i := range valid {
+		if valid[i] == Day(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidDayError(value)
+}
+
+// invalidDayError returns an *enum.InvalidValueError for the input,
+// listing the valid Day values.
+func invalidDayError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Day",
+		Input:   fmt.Sprint(input),
+		Allowed: dayNames(),
+	}
+}
+
+// Values returns the string representations of the valid Day values, it
+// implements the ent EnumValues interface.
+func (Day) Values() []string {
+	return dayNames()
+}
+
+// Parse parses the string representation of a Day, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Day) Parse(str string) (Day, error) {
+	enum, err := DayFromString(str)
+	if err != nil {
+		return Day(""), err
+	}
+
+	return *enum, nil
+}
+
+func dayNames() []string {
+	valid := validDays()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
 }
 
 func (day_enum Day) Validate() error {
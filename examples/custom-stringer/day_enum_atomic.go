@@ -0,0 +1,80 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"sync/atomic"
+)
+
+// AtomicDay is a Day that can be loaded and stored concurrently.
+// It stores the ordinal of the value offset by one, and the zero value of
+// Day as 0 so the zero value holds it.
+type AtomicDay struct {
+	value int64
+}
+
+// Load returns the current value.
+func (a *AtomicDay) Load() Day {
+	return decodeAtomicDay(atomic.LoadInt64(&a.value))
+}
+
+// Store stores value, or returns an error when it is invalid.
+func (a *AtomicDay) Store(value Day) error {
+	word, err := encodeAtomicDay(value)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(&a.value, word)
+	return nil
+}
+
+// Swap stores value and returns the previous value, or returns an error when
+// value is invalid.
+func (a *AtomicDay) Swap(value Day) (Day, error) {
+	word, err := encodeAtomicDay(value)
+	if err != nil {
+		return Day(""), err
+	}
+
+	return decodeAtomicDay(atomic.SwapInt64(&a.value, word)), nil
+}
+
+// CompareAndSwap stores new when the current value is old, or returns an error
+// when new is invalid.
+func (a *AtomicDay) CompareAndSwap(old, new Day) (bool, error) {
+	word, err := encodeAtomicDay(new)
+	if err != nil {
+		return false, err
+	}
+
+	var oldWord int64
+	if old != Day("") {
+		// An invalid value can never have been stored.
+		if oldWord, err = encodeAtomicDay(old); err != nil {
+			return false, nil
+		}
+	}
+
+	return atomic.CompareAndSwapInt64(&a.value, oldWord, word), nil
+}
+
+func encodeAtomicDay(value Day) (int64, error) {
+	ordinal := value.Ordinal()
+	if ordinal < 0 {
+		return 0, invalidDayError(string(value))
+	}
+	if value == Day("") {
+		return 0, nil
+	}
+
+	return int64(ordinal) + 1, nil
+}
+
+func decodeAtomicDay(word int64) Day {
+	if word == 0 {
+		return Day("")
+	}
+
+	value, _ := DayFromOrdinal(int(word - 1))
+	return value
+}
@@ -0,0 +1,75 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicDayCompareAndSwap(t *testing.T) {
+	valid := validDays()
+	first, last := valid[0], valid[len(valid)-1]
+
+	var value AtomicDay
+	if err := value.Store(first); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	swapped, err := value.CompareAndSwap(first, last)
+	if err != nil || !swapped {
+		t.Fatal("expected to swap", first, "for", last, "got:", swapped, err)
+	}
+	if loaded := value.Load(); loaded != last {
+		t.Error("expected", last, "got", loaded)
+	}
+
+	if len(valid) > 1 {
+		swapped, err = value.CompareAndSwap(first, last)
+		if err != nil || swapped {
+			t.Error("expected no swap of", first, "got:", swapped, err)
+		}
+	}
+}
+
+// TestAtomicDayRace is meant to be run with the race detector, go test -race.
+func TestAtomicDayRace(t *testing.T) {
+	valid := validDays()
+
+	var value AtomicDay
+	if err := value.Store(valid[0]); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := range valid {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				if err := value.Store(valid[i]); err != nil {
+					t.Error("expected no error got:", err)
+				}
+				if err := value.Load().Validate(); err != nil {
+					t.Error("expected a valid value got:", err)
+				}
+
+				old, err := value.Swap(valid[(i+j)%len(valid)])
+				if err != nil {
+					t.Error("expected no error got:", err)
+				} else if err := old.Validate(); err != nil {
+					t.Error("expected a valid value got:", err)
+				}
+
+				if _, err := value.CompareAndSwap(valid[i], valid[j%len(valid)]); err != nil {
+					t.Error("expected no error got:", err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if err := value.Load().Validate(); err != nil {
+		t.Error("expected a valid value got:", err)
+	}
+}
@@ -0,0 +1,44 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDayBSONMapKey(t *testing.T) {
+	type document struct {
+		Keys map[Day]int
+	}
+
+	valid := validDays()
+
+	input := document{Keys: map[Day]int{}}
+	for i := range valid {
+		input.Keys[valid[i]] = i
+	}
+
+	data, err := bson.Marshal(input)
+	if err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	// Keys with a string type are encoded either as is or through
+	// MarshalText, depending on the version, so only the round trip is
+	// checked.
+
+	var output document
+	if err := bson.Unmarshal(data, &output); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	if len(output.Keys) != len(input.Keys) {
+		t.Error("expected", input.Keys, "got", output.Keys)
+	}
+	for key, value := range input.Keys {
+		if output.Keys[key] != value {
+			t.Error("expected", value, "for", key, "got", output.Keys[key])
+		}
+	}
+}
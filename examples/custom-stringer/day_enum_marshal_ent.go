@@ -1,11 +1,9 @@
 // Code generated by go-enum, DO NOT EDIT.
 package day
 
-func (Day) Values() []string {
-	valid := validDays()
-	var values []string
-	for i := range valid {
-		values = append(values, valid[i].String())
-	}
-	return values
-}
+import (
+	"entgo.io/ent/schema/field"
+)
+
+// Day is used as an ent enum through its Values method.
+var _ field.EnumValues = Day("")
@@ -0,0 +1,43 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDayJSONMapKey(t *testing.T) {
+	valid := validDays()
+
+	input := map[Day]int{}
+	for i := range valid {
+		input[valid[i]] = i
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	// Keys with a string type are encoded either as is or through
+	// MarshalText, depending on the version, so only the round trip is
+	// checked.
+
+	var output map[Day]int
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	if len(output) != len(input) {
+		t.Error("expected", input, "got", output)
+	}
+	for key, value := range input {
+		if output[key] != value {
+			t.Error("expected", value, "for", key, "got", output[key])
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"not a valid Day": 1}`), &output); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
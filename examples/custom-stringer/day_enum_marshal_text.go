@@ -0,0 +1,29 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+
+func (day_enum Day) MarshalText() ([]byte, error) {
+	err := day_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(day_enum.String()), nil
+}
+
+func (day_enum *Day) UnmarshalText(text []byte) (error) {
+	enum, err := DayFromString(string(text))
+	if err != nil {
+		// Depending on the version, encoding/json and the mongo-driver encode
+		// map keys with an underlying string type as is, rather than through
+		// MarshalText.
+		enum, err = DayFromValue(string(text))
+	}
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+
+	return nil
+}
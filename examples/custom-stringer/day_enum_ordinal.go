@@ -0,0 +1,85 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"github.com/klippa-app/go-enum/enum"
+)
+
+// Ordinal returns the position of day_enum among the valid Day values, or
+// -1 when it is invalid.
+func (day_enum Day) Ordinal() int {
+	switch day_enum {
+	case Monday:
+		return 0
+	case Tuesday:
+		return 1
+	case Wednesday:
+		return 2
+	case Thursday:
+		return 3
+	case Friday:
+		return 4
+	case Saturday:
+		return 5
+	case Sunday:
+		return 6
+	default:
+		return -1
+	}
+}
+
+func DayFromOrdinal(ordinal int) (Day, error) {
+	valid := validDays()
+	if ordinal < 0 || ordinal >= len(valid) {
+		return Day(""), &enum.OrdinalRangeError{
+			Enum:    "Day",
+			Ordinal: ordinal,
+			Len:     len(valid),
+		}
+	}
+
+	return valid[ordinal], nil
+}
+
+// Next returns the valid value following day_enum, false when day_enum is the
+// last or an invalid value.
+func (day_enum Day) Next() (Day, bool) {
+	ordinal := day_enum.Ordinal()
+	if ordinal < 0 {
+		return day_enum, false
+	}
+
+	next, err := DayFromOrdinal(ordinal + 1)
+	return next, err == nil
+}
+
+// Prev returns the valid value preceding day_enum, false when day_enum is the
+// first or an invalid value.
+func (day_enum Day) Prev() (Day, bool) {
+	ordinal := day_enum.Ordinal()
+	if ordinal < 0 {
+		return day_enum, false
+	}
+
+	prev, err := DayFromOrdinal(ordinal - 1)
+	return prev, err == nil
+}
+
+// Compare returns -1, 0 or 1 when day_enum is ordered before, equal to or
+// after other. Invalid values are ordered before the valid values, by their
+// underlying value.
+func (day_enum Day) Compare(other Day) int {
+	a, b := day_enum.Ordinal(), other.Ordinal()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case a >= 0 || day_enum == other:
+		return 0
+	case day_enum < other:
+		return -1
+	default:
+		return 1
+	}
+}
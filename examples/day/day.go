@@ -1,17 +1,17 @@
-//go:generate go run --mod=mod github.com/klippa-app/go-enum -case=upper_snake -gql=full -json -bson -xml -ent
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -case=upper_snake -gql=full -gql-goenum -gql-groups -json -bson -xml -ent -test -decode -toml -msgpack -cbor -avro -binary=id -predicates -set -flag=pflag,cobra
 package day
 
 type Day int
 
 const (
-	Unknown Day = 0 //enum:invalid
-	Monday  Day = 1 << iota
-	Tuesday
-	Wednesday
-	Thursday
-	Friday
+	Unknown   Day = 0         //enum:invalid
+	Monday    Day = 1 << iota //enum:id=1,group=weekday
+	Tuesday                   //enum:id=2,group=weekday
+	Wednesday                 //enum:id=3,group=weekday
+	Thursday                  //enum:id=4,group=weekday
+	Friday                    //enum:id=5,group=weekday
 )
 const (
-	Saturday = Friday<<iota + 1
-	Sunday
+	Saturday = Friday<<iota + 1 //enum:id=6,group=weekend,rest
+	Sunday                      //enum:id=7,group=weekend,rest
 )
@@ -3,6 +3,8 @@ package day
 
 import (
 	"fmt"
+
+	"github.com/klippa-app/go-enum/enum"
 )
 
 func AllDays() []Day {
@@ -59,6 +61,10 @@ func (day_enum Day) String() string {
 	case Sunday:
 		return "SUNDAY"
 	default:
+		// flag prints the zero value of a flag in its usage.
+		if day_enum == Day(0) {
+			return ""
+		}
 		panic(fmt.Sprintf("no default for enum %T, invalid value: '%#v'", day_enum, day_enum))
 	}
 }
@@ -71,10 +77,114 @@ func DayFromString(val string) (*Day, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("%s is not a valid Day", val)
+	return nil, invalidDayError(val)
+}
+
+func DayFromValue(value int) (*Day, error) {
+	valid := validDays()
+	for i := range valid {
+		if valid[i] == Day(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidDayError(value)
+}
+
+// invalidDayError returns an *enum.InvalidValueError for the input,
+// listing the valid Day values.
+func invalidDayError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Day",
+		Input:   fmt.Sprint(input),
+		Allowed: dayNames(),
+	}
+}
+
+// Values returns the string representations of the valid Day values, it
+// implements the ent EnumValues interface.
+func (Day) Values() []string {
+	return dayNames()
+}
+
+// Parse parses the string representation of a Day, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Day) Parse(str string) (Day, error) {
+	enum, err := DayFromString(str)
+	if err != nil {
+		return Day(0), err
+	}
+
+	return *enum, nil
+}
+
+func dayNames() []string {
+	valid := validDays()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
 }
 
 func (day_enum Day) Validate() error {
 	_, err := DayFromString(day_enum.String())
 	return err
 }
+
+// IsUnknown reports whether day_enum is Unknown.
+func (day_enum Day) IsUnknown() bool {
+	return day_enum == Unknown
+}
+
+// IsMonday reports whether day_enum is Monday.
+func (day_enum Day) IsMonday() bool {
+	return day_enum == Monday
+}
+
+// IsTuesday reports whether day_enum is Tuesday.
+func (day_enum Day) IsTuesday() bool {
+	return day_enum == Tuesday
+}
+
+// IsWednesday reports whether day_enum is Wednesday.
+func (day_enum Day) IsWednesday() bool {
+	return day_enum == Wednesday
+}
+
+// IsThursday reports whether day_enum is Thursday.
+func (day_enum Day) IsThursday() bool {
+	return day_enum == Thursday
+}
+
+// IsFriday reports whether day_enum is Friday.
+func (day_enum Day) IsFriday() bool {
+	return day_enum == Friday
+}
+
+// IsSaturday reports whether day_enum is Saturday.
+func (day_enum Day) IsSaturday() bool {
+	return day_enum == Saturday
+}
+
+// IsSunday reports whether day_enum is Sunday.
+func (day_enum Day) IsSunday() bool {
+	return day_enum == Sunday
+}
+
+// IsValid reports whether day_enum is a valid Day.
+func (day_enum Day) IsValid() bool {
+	_, err := DayFromValue(int(day_enum))
+	return err == nil
+}
+
+// IsDefault reports whether day_enum is the default Day, the zero value as
+// there is no //enum:default.
+func (day_enum Day) IsDefault() bool {
+	return day_enum.IsZero()
+}
+
+// IsZero reports whether day_enum is the zero value of Day.
+func (day_enum Day) IsZero() bool {
+	return day_enum == Day(0)
+}
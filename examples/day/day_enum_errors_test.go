@@ -0,0 +1,44 @@
+package day_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/day"
+)
+
+func TestDayInvalidValueError(t *testing.T) {
+	_, err := day.DayFromString("SOMEDAY")
+	if !errors.Is(err, enum.ErrInvalid) {
+		t.Fatalf("expected enum.ErrInvalid, got %v", err)
+	}
+
+	var invalid *enum.InvalidValueError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected an *enum.InvalidValueError, got %T", err)
+	}
+
+	want := &enum.InvalidValueError{
+		Enum:    "Day",
+		Input:   "SOMEDAY",
+		Allowed: []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"},
+	}
+	if !reflect.DeepEqual(invalid, want) {
+		t.Errorf("error = %#v, want %#v", invalid, want)
+	}
+
+	if err.Error() != "SOMEDAY is not a valid Day" {
+		t.Errorf("message = %s", err)
+	}
+
+	// Marshalers return the same error.
+	var dag day.Day
+	if err := dag.UnmarshalText([]byte("SOMEDAY")); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected enum.ErrInvalid from UnmarshalText, got %v", err)
+	}
+	if err := dag.UnmarshalBinary([]byte{9}); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected enum.ErrInvalid from UnmarshalBinary, got %v", err)
+	}
+}
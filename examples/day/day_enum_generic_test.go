@@ -0,0 +1,31 @@
+package day_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/day"
+)
+
+func TestDayGeneric(t *testing.T) {
+	dag, err := enum.Parse[day.Day]("MONDAY")
+	if err != nil || dag != day.Monday {
+		t.Errorf("Parse = %v, %v, want %v", dag, err, day.Monday)
+	}
+
+	if _, err := enum.Parse[day.Day]("SOMEDAY"); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected enum.ErrInvalid, got %v", err)
+	}
+
+	want := []day.Day{day.Monday, day.Tuesday, day.Wednesday, day.Thursday, day.Friday, day.Saturday, day.Sunday}
+	if all := enum.All[day.Day](); !reflect.DeepEqual(all, want) {
+		t.Errorf("All = %v, want %v", all, want)
+	}
+
+	names := []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+	if got := enum.Names[day.Day](); !reflect.DeepEqual(got, names) {
+		t.Errorf("Names = %v, want %v", got, names)
+	}
+}
@@ -0,0 +1,74 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+// IsWeekday reports whether day_enum is in the weekday group.
+func (day_enum Day) IsWeekday() bool {
+	switch day_enum {
+	case Monday, Tuesday, Wednesday, Thursday, Friday:
+		return true
+	default:
+		return false
+	}
+}
+
+// WeekdayDays returns the Day values in the weekday group.
+func WeekdayDays() []Day {
+	return []Day{
+		Monday,
+		Tuesday,
+		Wednesday,
+		Thursday,
+		Friday,
+	}
+}
+
+// IsWeekend reports whether day_enum is in the weekend group.
+func (day_enum Day) IsWeekend() bool {
+	switch day_enum {
+	case Saturday, Sunday:
+		return true
+	default:
+		return false
+	}
+}
+
+// WeekendDays returns the Day values in the weekend group.
+func WeekendDays() []Day {
+	return []Day{
+		Saturday,
+		Sunday,
+	}
+}
+
+// IsRest reports whether day_enum is in the rest group.
+func (day_enum Day) IsRest() bool {
+	switch day_enum {
+	case Saturday, Sunday:
+		return true
+	default:
+		return false
+	}
+}
+
+// RestDays returns the Day values in the rest group.
+func RestDays() []Day {
+	return []Day{
+		Saturday,
+		Sunday,
+	}
+}
+
+// Groups returns the names of the groups day_enum is in.
+func (day_enum Day) Groups() []string {
+	var groups []string
+	if day_enum.IsWeekday() {
+		groups = append(groups, "weekday")
+	}
+	if day_enum.IsWeekend() {
+		groups = append(groups, "weekend")
+	}
+	if day_enum.IsRest() {
+		groups = append(groups, "rest")
+	}
+	return groups
+}
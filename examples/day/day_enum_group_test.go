@@ -0,0 +1,28 @@
+package day_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/day"
+)
+
+func TestDayGroups(t *testing.T) {
+	if !day.Saturday.IsWeekend() || !day.Sunday.IsRest() || day.Monday.IsWeekend() {
+		t.Error("expected only saturday and sunday in the weekend")
+	}
+	if !day.Friday.IsWeekday() || day.Sunday.IsWeekday() || day.Unknown.IsWeekday() {
+		t.Error("expected only monday through friday to be weekdays")
+	}
+
+	if got, want := day.WeekendDays(), []day.Day{day.Saturday, day.Sunday}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WeekendDays() = %v, want %v", got, want)
+	}
+
+	if got, want := day.Sunday.Groups(), []string{"weekend", "rest"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sunday.Groups() = %v, want %v", got, want)
+	}
+	if got := day.Unknown.Groups(); got != nil {
+		t.Errorf("Unknown.Groups() = %v, want none", got)
+	}
+}
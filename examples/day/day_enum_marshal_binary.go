@@ -0,0 +1,71 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+func (day_enum Day) MarshalBinary() ([]byte, error) {
+	err := day_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var id uint64
+	switch day_enum {
+	case Monday:
+		id = 1
+	case Tuesday:
+		id = 2
+	case Wednesday:
+		id = 3
+	case Thursday:
+		id = 4
+	case Friday:
+		id = 5
+	case Saturday:
+		id = 6
+	case Sunday:
+		id = 7
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutUvarint(buf, id)], nil
+}
+
+func (day_enum *Day) UnmarshalBinary(data []byte) error {
+	id, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("invalid binary Day id")
+	}
+
+	switch id {
+	case 1:
+		*day_enum = Monday
+	case 2:
+		*day_enum = Tuesday
+	case 3:
+		*day_enum = Wednesday
+	case 4:
+		*day_enum = Thursday
+	case 5:
+		*day_enum = Friday
+	case 6:
+		*day_enum = Saturday
+	case 7:
+		*day_enum = Sunday
+	default:
+		return invalidDayError(id)
+	}
+
+	return nil
+}
+
+func (day_enum Day) GobEncode() ([]byte, error) {
+	return day_enum.MarshalBinary()
+}
+
+func (day_enum *Day) GobDecode(data []byte) error {
+	return day_enum.UnmarshalBinary(data)
+}
@@ -0,0 +1,52 @@
+package day_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/day"
+)
+
+func TestDayBinary(t *testing.T) {
+	data, err := day.Sunday.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sunday is stored as its //enum:id, not as its value.
+	if !bytes.Equal(data, []byte{7}) {
+		t.Errorf("data = %v, want [7]", data)
+	}
+
+	var dag day.Day
+	if err := dag.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if dag != day.Sunday {
+		t.Errorf("dag = %v, want %v", dag, day.Sunday)
+	}
+
+	for _, input := range [][]byte{{0}, {8}, {}, {1, 2}} {
+		if err := dag.UnmarshalBinary(input); err == nil {
+			t.Error("expected an error for", input)
+		}
+	}
+}
+
+func TestDayGob(t *testing.T) {
+	type message struct{ Dag day.Day }
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(message{Dag: day.Thursday}); err != nil {
+		t.Fatal(err)
+	}
+
+	var res message
+	if err := gob.NewDecoder(&buf).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Dag != day.Thursday {
+		t.Errorf("dag = %v, want %v", res.Dag, day.Thursday)
+	}
+}
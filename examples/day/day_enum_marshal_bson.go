@@ -2,6 +2,8 @@
 package day
 
 import (
+	"fmt"
+
 	"github.com/globalsign/mgo/bson"
 
 	mongo "go.mongodb.org/mongo-driver/bson"
@@ -38,18 +40,28 @@ func (day_enum *Day) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
-func (day_enum *Day) UnmarshalBSON(data []byte) error {
-	return day_enum.SetBSON(bson.Raw{
-		Kind: bson.ElementString,
-		Data: data,
-	})
-}
-
 func (day_enum Day) MarshalBSONValue() (bsontype.Type, []byte, error) {
 	err := day_enum.Validate()
 	if err != nil {
-		return bsontype.Undefined,nil, err
+		return bsontype.Undefined, nil, err
 	}
 
 	return mongo.MarshalValue(day_enum.String())
 }
+
+func (day_enum *Day) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	str, ok := raw.StringValueOK()
+	if !ok {
+		return fmt.Errorf("cannot unmarshal BSON %s into Day", raw.Type)
+	}
+
+	enum, err := DayFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
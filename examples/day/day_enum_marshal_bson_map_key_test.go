@@ -0,0 +1,54 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDayBSONMapKey(t *testing.T) {
+	type document struct {
+		Keys map[Day]int
+	}
+
+	valid := validDays()
+
+	input := document{Keys: map[Day]int{}}
+	for i := range valid {
+		input.Keys[valid[i]] = i
+	}
+
+	data, err := bson.Marshal(input)
+	if err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	var keys struct {
+		Keys map[string]int
+	}
+	if err := bson.Unmarshal(data, &keys); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	for i := range valid {
+		key := valid[i].String()
+		if value, ok := keys.Keys[key]; !ok || value != i {
+			t.Error("expected key", key, "with value", i, "got", keys.Keys)
+		}
+	}
+
+	var output document
+	if err := bson.Unmarshal(data, &output); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	if len(output.Keys) != len(input.Keys) {
+		t.Error("expected", input.Keys, "got", output.Keys)
+	}
+	for key, value := range input.Keys {
+		if output.Keys[key] != value {
+			t.Error("expected", value, "for", key, "got", output.Keys[key])
+		}
+	}
+}
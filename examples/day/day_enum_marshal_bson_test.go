@@ -1,9 +1,10 @@
 package day_test
 
 import (
-	"fmt"
+	"errors"
 	"testing"
 
+	"github.com/klippa-app/go-enum/enum"
 	"github.com/klippa-app/go-enum/examples/day"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -53,7 +54,7 @@ func TestDagBSON(t *testing.T) {
 				err    error
 				output marshallableStruct
 			}{
-				err: fmt.Errorf("error decoding key dag: monday is not a valid Day"),
+				err: enum.ErrInvalid,
 				output: marshallableStruct{
 					Dag: day.Unknown,
 				},
@@ -75,7 +76,7 @@ func TestDagBSON(t *testing.T) {
 				t.Error("expected no error got:", err)
 			}
 		} else if err != nil {
-			if err.Error() != test.want.err.Error() {
+			if !errors.Is(err, test.want.err) {
 				t.Error("expected", test.want.err, "got", err)
 				continue
 			}
@@ -0,0 +1,30 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"github.com/fxamacker/cbor/v2"
+)
+
+func (day_enum Day) MarshalCBOR() ([]byte, error) {
+	err := day_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return cbor.Marshal(day_enum.String())
+}
+
+func (day_enum *Day) UnmarshalCBOR(data []byte) error {
+	var str string
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	enum, err := DayFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
@@ -0,0 +1,42 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"reflect"
+)
+
+// Decode implements the envconfig Decoder interface.
+func (day_enum *Day) Decode(value string) error {
+	enum, err := decodeDay(value)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
+
+// DayDecodeHook is a mapstructure DecodeHookFuncType, decoding strings
+// into Day. Other data is passed through unchanged.
+func DayDecodeHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if to != reflect.TypeOf(Day(0)) {
+		return data, nil
+	}
+
+	str, ok := data.(string)
+	if !ok {
+		return data, nil
+	}
+
+	enum, err := decodeDay(str)
+	if err != nil {
+		return nil, err
+	}
+
+	return *enum, nil
+}
+
+// decodeDay accepts the same input as UnmarshalJSON.
+func decodeDay(str string) (*Day, error) {
+	return DayFromString(str)
+}
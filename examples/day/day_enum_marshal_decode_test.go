@@ -0,0 +1,44 @@
+package day_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/day"
+)
+
+func TestDayDecode(t *testing.T) {
+	var dag day.Day
+	if err := dag.Decode("WEDNESDAY"); err != nil {
+		t.Fatal(err)
+	}
+	if dag != day.Wednesday {
+		t.Errorf("dag = %v, want %v", dag, day.Wednesday)
+	}
+
+	if err := dag.Decode("UNKNOWN"); err == nil {
+		t.Error("expected an error for an invalid day")
+	}
+}
+
+func TestDayDecodeHook(t *testing.T) {
+	to := reflect.TypeOf(day.Day(0))
+	from := reflect.TypeOf("")
+
+	res, err := day.DayDecodeHook(from, to, "SATURDAY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != day.Saturday {
+		t.Errorf("res = %v, want %v", res, day.Saturday)
+	}
+
+	if _, err := day.DayDecodeHook(from, to, "someday"); err == nil {
+		t.Error("expected an error for an invalid day")
+	}
+
+	// Other types are left to the decoder.
+	if res, err := day.DayDecodeHook(from, from, "someday"); err != nil || res != "someday" {
+		t.Errorf("res = %v, %v, want the data unchanged", res, err)
+	}
+}
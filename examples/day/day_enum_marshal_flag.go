@@ -0,0 +1,63 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"flag"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Set implements flag.Value and pflag.Value, only accepting valid Day values.
+func (day_enum *Day) Set(str string) error {
+	enum, err := DayFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
+
+// Type returns the name of the type shown in the usage of the flag.
+func (day_enum *Day) Type() string {
+	return "day"
+}
+
+// DayFlag defines a Day flag with the specified name, default value
+// and usage string, the returned pointer stores the value of the flag.
+func DayFlag(fs *flag.FlagSet, name string, value Day, usage string) *Day {
+	p := new(Day)
+	*p = value
+	fs.Var(p, name, usage)
+	return p
+}
+
+// DayPFlag defines a Day flag with the specified name, default value
+// and usage string, the returned pointer stores the value of the flag.
+func DayPFlag(fs *pflag.FlagSet, name string, value Day, usage string) *Day {
+	p := new(Day)
+	*p = value
+	fs.Var(p, name, usage)
+	return p
+}
+
+// DayCompletion completes the valid Day values, it can be used as
+// ValidArgsFunction or as the completion of a flag.
+func DayCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var completions []string
+	for _, v := range validDays() {
+		if strings.HasPrefix(v.String(), toComplete) {
+			completions = append(completions, v.String())
+		}
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// RegisterDayFlagCompletion registers the completion of the valid Day
+// values for the flag with the given name.
+func RegisterDayFlagCompletion(cmd *cobra.Command, name string) error {
+	return cmd.RegisterFlagCompletionFunc(name, DayCompletion)
+}
@@ -0,0 +1,79 @@
+package day_test
+
+import (
+	"flag"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/day"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func TestDayFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	dag := day.DayFlag(fs, "dag", day.Monday, "the day")
+
+	if *dag != day.Monday {
+		t.Errorf("default = %v, want %v", *dag, day.Monday)
+	}
+
+	if err := fs.Parse([]string{"-dag", "FRIDAY"}); err != nil {
+		t.Fatal(err)
+	}
+	if *dag != day.Friday {
+		t.Errorf("dag = %v, want %v", *dag, day.Friday)
+	}
+
+	if err := fs.Parse([]string{"-dag", "UNKNOWN"}); err == nil {
+		t.Error("expected an error for an invalid day")
+	}
+}
+
+func TestDayPFlag(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	dag := day.DayPFlag(fs, "dag", day.Monday, "the day")
+
+	if err := fs.Parse([]string{"--dag", "SUNDAY"}); err != nil {
+		t.Fatal(err)
+	}
+	if *dag != day.Sunday {
+		t.Errorf("dag = %v, want %v", *dag, day.Sunday)
+	}
+
+	if got := fs.Lookup("dag").Value.Type(); got != "day" {
+		t.Errorf("type = %s, want day", got)
+	}
+}
+
+func TestDayCompletion(t *testing.T) {
+	completions, directive := day.DayCompletion(&cobra.Command{}, nil, "T")
+
+	if want := []string{"TUESDAY", "THURSDAY"}; !reflect.DeepEqual(completions, want) {
+		t.Errorf("completions = %v, want %v", completions, want)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want %v", directive, cobra.ShellCompDirectiveNoFileComp)
+	}
+}
+
+func TestDayFlagUsage(t *testing.T) {
+	var usage strings.Builder
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&usage)
+
+	// The zero value is invalid, and has to be printed without panicking.
+	day.DayFlag(fs, "dag", day.Day(0), "the day")
+	fs.PrintDefaults()
+
+	if strings.Contains(usage.String(), "PANIC") {
+		t.Errorf("unexpected usage %q", usage.String())
+	}
+	if err := day.Day(0).Validate(); err == nil {
+		t.Error("expected the zero value to stay invalid")
+	}
+}
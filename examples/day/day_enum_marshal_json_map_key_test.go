@@ -0,0 +1,51 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDayJSONMapKey(t *testing.T) {
+	valid := validDays()
+
+	input := map[Day]int{}
+	for i := range valid {
+		input[valid[i]] = i
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	var keys map[string]int
+	if err := json.Unmarshal(data, &keys); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	for i := range valid {
+		key := valid[i].String()
+		if value, ok := keys[key]; !ok || value != i {
+			t.Error("expected key", key, "with value", i, "got", keys)
+		}
+	}
+
+	var output map[Day]int
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	if len(output) != len(input) {
+		t.Error("expected", input, "got", output)
+	}
+	for key, value := range input {
+		if output[key] != value {
+			t.Error("expected", value, "for", key, "got", output[key])
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"not a valid Day": 1}`), &output); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
@@ -0,0 +1,30 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func (day_enum Day) EncodeMsgpack(enc *msgpack.Encoder) error {
+	err := day_enum.Validate()
+	if err != nil {
+		return err
+	}
+
+	return enc.EncodeString(day_enum.String())
+}
+
+func (day_enum *Day) DecodeMsgpack(dec *msgpack.Decoder) error {
+	str, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+
+	enum, err := DayFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
@@ -3,6 +3,11 @@ package day
 
 
 func (day_enum Day) MarshalText() ([]byte, error) {
+	err := day_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return []byte(day_enum.String()), nil
 }
 
@@ -0,0 +1,34 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// MarshalTOML implements the BurntSushi/toml Marshaler interface, other
+// libraries use the text marshalers.
+func (day_enum Day) MarshalTOML() ([]byte, error) {
+	err := day_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strconv.Quote(day_enum.String())), nil
+}
+
+// UnmarshalTOML implements the BurntSushi/toml Unmarshaler interface.
+func (day_enum *Day) UnmarshalTOML(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal TOML %T into Day", val)
+	}
+
+	enum, err := DayFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
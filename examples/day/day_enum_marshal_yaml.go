@@ -0,0 +1,31 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+func (day_enum Day) MarshalYAML() (interface{}, error) {
+	err := day_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return day_enum.String(), nil
+}
+
+func (day_enum *Day) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d, column %d: cannot unmarshal a non scalar node into Day", node.Line, node.Column)
+	}
+
+	enum, err := DayFromString(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d, column %d: %w", node.Line, node.Column, err)
+	}
+
+	*day_enum = *enum
+	return nil
+}
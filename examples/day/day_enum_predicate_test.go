@@ -0,0 +1,36 @@
+package day_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/klippa-app/go-enum/examples/day"
+)
+
+func TestDayPredicates(t *testing.T) {
+	if !day.Monday.IsMonday() || day.Monday.IsTuesday() || day.Sunday.IsMonday() {
+		t.Error("expected IsMonday to only hold for Monday")
+	}
+
+	if !day.Friday.IsValid() || day.Unknown.IsValid() || day.Day(3).IsValid() {
+		t.Error("expected only declared valid values to be valid")
+	}
+
+	// Day has no //enum:default, so the zero value is the default.
+	if !day.Unknown.IsZero() || !day.Unknown.IsDefault() || day.Monday.IsDefault() {
+		t.Error("expected Unknown to be the zero and default value")
+	}
+}
+
+func TestDayPredicatesInTemplate(t *testing.T) {
+	tmpl := template.Must(template.New("").Parse(`{{ if .IsSaturday }}lie in{{ else }}work{{ end }}`))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, day.Saturday); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "lie in" {
+		t.Errorf("unexpected template output %q", buf.String())
+	}
+}
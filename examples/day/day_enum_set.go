@@ -0,0 +1,200 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/klippa-app/go-enum/enum"
+
+	"github.com/globalsign/mgo/bson"
+
+	mongo "go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// DaySet is a set of Day values, stored as a bitset over their
+// ordinals. The zero value is an empty set.
+type DaySet struct {
+	bits [(7 + 63) / 64]uint64
+}
+
+// NewDaySet returns a set of the values, or an error when one of them is
+// invalid.
+func NewDaySet(values ...Day) (DaySet, error) {
+	var set DaySet
+	err := set.Add(values...)
+	return set, err
+}
+
+// Add adds the values to the set, it adds none of them when one is invalid.
+func (set *DaySet) Add(values ...Day) error {
+	for _, value := range values {
+		if value.Ordinal() < 0 {
+			return invalidDayError(int(value))
+		}
+	}
+
+	for _, value := range values {
+		ordinal := value.Ordinal()
+		set.bits[ordinal/64] |= 1 << (ordinal % 64)
+	}
+	return nil
+}
+
+// Remove removes the values from the set.
+func (set *DaySet) Remove(values ...Day) {
+	for _, value := range values {
+		if ordinal := value.Ordinal(); ordinal >= 0 {
+			set.bits[ordinal/64] &^= 1 << (ordinal % 64)
+		}
+	}
+}
+
+// Has reports whether value is in the set.
+func (set DaySet) Has(value Day) bool {
+	ordinal := value.Ordinal()
+	return ordinal >= 0 && set.bits[ordinal/64]&(1<<(ordinal%64)) != 0
+}
+
+// Union returns the values in either set.
+func (set DaySet) Union(other DaySet) DaySet {
+	for i := range set.bits {
+		set.bits[i] |= other.bits[i]
+	}
+	return set
+}
+
+// Intersect returns the values in both sets.
+func (set DaySet) Intersect(other DaySet) DaySet {
+	for i := range set.bits {
+		set.bits[i] &= other.bits[i]
+	}
+	return set
+}
+
+// Difference returns the values in set that are not in other.
+func (set DaySet) Difference(other DaySet) DaySet {
+	for i := range set.bits {
+		set.bits[i] &^= other.bits[i]
+	}
+	return set
+}
+
+// Len returns the number of values in the set.
+func (set DaySet) Len() int {
+	n := 0
+	for i := range set.bits {
+		n += bits.OnesCount64(set.bits[i])
+	}
+	return n
+}
+
+// Values returns the values in the set, in the order of their ordinals.
+func (set DaySet) Values() []Day {
+	values := []Day{}
+	for _, value := range validDays() {
+		if set.Has(value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// MarshalJSON marshals the set as an array of its values.
+func (set DaySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.Values())
+}
+
+func (set *DaySet) UnmarshalJSON(data []byte) error {
+	var values []Day
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*set = DaySet{}
+	return set.Add(values...)
+}
+
+func (set DaySet) GetBSON() (interface{}, error) {
+	return set.Values(), nil
+}
+
+func (set *DaySet) SetBSON(raw bson.Raw) error {
+	var values []Day
+	if err := raw.Unmarshal(&values); err != nil {
+		return err
+	}
+
+	*set = DaySet{}
+	return set.Add(values...)
+}
+
+func (set DaySet) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return mongo.MarshalValue(set.Values())
+}
+
+func (set *DaySet) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	var values []Day
+	if err := raw.Unmarshal(&values); err != nil {
+		return err
+	}
+
+	*set = DaySet{}
+	return set.Add(values...)
+}
+
+// Value stores the set as comma separated values, quoting elements
+// that contain separators, quotes or braces.
+func (set DaySet) Value() (driver.Value, error) {
+	values := set.Values()
+	parts := make([]string, len(values))
+	for i := range values {
+		value, err := values[i].Value()
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = enum.QuoteArrayElement(fmt.Sprint(value))
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// Scan accepts both comma separated values and a postgres array, elements
+// may be quoted.
+func (set *DaySet) Scan(val any) error {
+	var str string
+
+	switch v := val.(type) {
+	case nil:
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+
+	*set = DaySet{}
+
+	parts, err := enum.SplitArray(str)
+	if err != nil {
+		return err
+	}
+
+	for _, part := range parts {
+		var value Day
+		if err := value.Scan(part); err != nil {
+			return err
+		}
+		if err := set.Add(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package day_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/klippa-app/go-enum/examples/day"
+)
+
+func TestDaySet(t *testing.T) {
+	weekend, err := day.NewDaySet(day.WeekendDays()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(weekend)
+	if err != nil || string(data) != `["SATURDAY","SUNDAY"]` {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+
+	data, err = bson.Marshal(bson.M{"days": weekend})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var res struct{ Days day.DaySet }
+	if err := bson.Unmarshal(data, &res); err != nil || res.Days != weekend {
+		t.Errorf("bson.Unmarshal() = %v, %v, want %v", res.Days.Values(), err, weekend.Values())
+	}
+}
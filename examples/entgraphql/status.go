@@ -0,0 +1,11 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -gql=full -ent=gql
+package entgraphql
+
+type Status int
+
+const (
+	Unknown Status = iota //enum:invalid
+	Draft
+	Published
+	Archived
+)
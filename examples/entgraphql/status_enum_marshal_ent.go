@@ -0,0 +1,19 @@
+// Code generated by go-enum, DO NOT EDIT.
+package entgraphql
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Status is used as an ent enum through its Values method.
+var _ field.EnumValues = Status(0)
+
+// StatusField returns an ent schema field that stores Status.
+func StatusField(name string) ent.Field {
+	return field.Enum(name).
+		GoType(Status(0)).
+		// Use the enum of the GraphQL schema, rather than generating one.
+		Annotations(entgql.Type("Status"))
+}
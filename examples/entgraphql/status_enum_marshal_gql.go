@@ -0,0 +1,27 @@
+// Code generated by go-enum, DO NOT EDIT.
+package entgraphql
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+func (status_enum Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(status_enum.String()))
+}
+
+func (status_enum *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum value %T must be a string", val)
+	}
+
+	enum, err := StatusFromString(str)
+	if err != nil {
+		return err
+	}
+	 
+	*status_enum = *enum
+	return nil
+}
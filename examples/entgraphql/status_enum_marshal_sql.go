@@ -0,0 +1,32 @@
+// Code generated by go-enum, DO NOT EDIT.
+package entgraphql
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+func (status_enum Status) Value() (driver.Value, error) {
+	return status_enum.String(), status_enum.Validate()
+}
+
+func (status_enum *Status) Scan(val any) error {
+	var str string
+
+	switch v := val.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+
+	enum, err := StatusFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*status_enum = *enum
+	return nil
+}
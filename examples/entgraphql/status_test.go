@@ -0,0 +1,34 @@
+package entgraphql_test
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql"
+
+	"github.com/klippa-app/go-enum/examples/entgraphql"
+)
+
+func TestStatusFieldAnnotation(t *testing.T) {
+	descriptor := entgraphql.StatusField("status").Descriptor()
+	if descriptor.Err != nil {
+		t.Fatal(descriptor.Err)
+	}
+
+	if len(descriptor.Annotations) != 1 {
+		t.Fatalf("expected a single annotation, got %v", descriptor.Annotations)
+	}
+	annotation, ok := descriptor.Annotations[0].(entgql.Annotation)
+	if !ok || annotation.Type != "Status" {
+		t.Errorf("expected the Status enum of status_enum.graphql, got %#v", descriptor.Annotations[0])
+	}
+
+	want := []string{"draft", "published", "archived"}
+	if len(descriptor.Enums) != len(want) {
+		t.Fatalf("expected %v, got %v", want, descriptor.Enums)
+	}
+	for i := range want {
+		if descriptor.Enums[i].V != want[i] {
+			t.Errorf("expected %s, got %s", want[i], descriptor.Enums[i].V)
+		}
+	}
+}
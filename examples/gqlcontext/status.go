@@ -0,0 +1,10 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -gql-context
+package gqlcontext
+
+type Status int
+
+const (
+	Unknown Status = iota //enum:invalid
+	Draft
+	Published
+)
@@ -0,0 +1,49 @@
+// Code generated by go-enum, DO NOT EDIT.
+package gqlcontext
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func (status_enum Status) MarshalGQLContext(ctx context.Context, w io.Writer) error {
+	// Validate through the value, as the stringer panics on invalid values.
+	_, err := StatusFromValue(int(status_enum))
+	if err != nil {
+		return statusGQLError(ctx, err)
+	}
+
+	_, err = io.WriteString(w, strconv.Quote(status_enum.String()))
+	return err
+}
+
+func (status_enum *Status) UnmarshalGQLContext(ctx context.Context, val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return statusGQLError(ctx, fmt.Errorf("enum value %T must be a string", val))
+	}
+
+	enum, err := StatusFromString(str)
+	if err != nil {
+		return statusGQLError(ctx, err)
+	}
+
+	*status_enum = *enum
+	return nil
+}
+
+// statusGQLError wraps err in an error on the path of the current field,
+// listing the allowed values of Status in its extensions.
+func statusGQLError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := gqlerror.WrapPath(graphql.GetPath(ctx), err)
+	gqlErr.Extensions = map[string]interface{}{
+		"enum":    "Status",
+		"allowed": statusNames(),
+	}
+	return gqlErr
+}
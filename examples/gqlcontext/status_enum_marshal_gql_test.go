@@ -0,0 +1,70 @@
+package gqlcontext_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/gqlcontext"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestStatusGQLContext(t *testing.T) {
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("status"))
+
+	var buf bytes.Buffer
+	if err := gqlcontext.Published.MarshalGQLContext(ctx, &buf); err != nil || buf.String() != `"published"` {
+		t.Errorf("MarshalGQLContext() = %s, %v", buf.String(), err)
+	}
+
+	var status gqlcontext.Status
+	if err := status.UnmarshalGQLContext(ctx, "draft"); err != nil || status != gqlcontext.Draft {
+		t.Errorf("UnmarshalGQLContext(draft) = %v, %v", status, err)
+	}
+}
+
+func TestStatusGQLContextError(t *testing.T) {
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("status"))
+
+	tests := map[string]func() error{
+		"unmarshal unknown": func() error {
+			var status gqlcontext.Status
+			return status.UnmarshalGQLContext(ctx, "archived")
+		},
+		"unmarshal non string": func() error {
+			var status gqlcontext.Status
+			return status.UnmarshalGQLContext(ctx, 1)
+		},
+		"marshal invalid": func() error {
+			return gqlcontext.Unknown.MarshalGQLContext(ctx, &bytes.Buffer{})
+		},
+	}
+
+	for name, test := range tests {
+		err := test()
+
+		var gqlErr *gqlerror.Error
+		if !errors.As(err, &gqlErr) {
+			t.Errorf("%s: expected a *gqlerror.Error, got %v", name, err)
+			continue
+		}
+		if name != "unmarshal non string" && !errors.Is(err, enum.ErrInvalid) {
+			t.Errorf("%s: expected the error to wrap enum.ErrInvalid, got %v", name, err)
+		}
+
+		if want := (ast.Path{ast.PathName("status")}); !reflect.DeepEqual(gqlErr.Path, want) {
+			t.Errorf("%s: path = %v, want %v", name, gqlErr.Path, want)
+		}
+		if gqlErr.Extensions["enum"] != "Status" {
+			t.Errorf("%s: enum extension = %v, want Status", name, gqlErr.Extensions["enum"])
+		}
+		if allowed := gqlErr.Extensions["allowed"]; !reflect.DeepEqual(allowed, []string{"draft", "published"}) {
+			t.Errorf("%s: allowed extension = %v", name, allowed)
+		}
+	}
+}
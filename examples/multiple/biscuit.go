@@ -1,4 +1,4 @@
-//go:generate go run --mod=mod github.com/klippa-app/go-enum -case=snake -gql=full -json -bson -xml -ent
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -case=snake -gql=full -json -bson -xml -ent -bson-codec -sql-ddl=postgres -gorm -sqlc
 package multiple
 
 type Biscuit int
@@ -10,4 +10,5 @@ const (
 	BiscuitJammieDodger
 	BiscuitShortbread
 	BiscuitGingerNut
+	BiscuitBourbon
 )
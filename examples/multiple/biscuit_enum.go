@@ -3,6 +3,8 @@ package multiple
 
 import (
 	"fmt"
+
+	"github.com/klippa-app/go-enum/enum"
 )
 
 func AllBiscuits() []Biscuit {
@@ -13,6 +15,7 @@ func AllBiscuits() []Biscuit {
 		BiscuitJammieDodger,
 		BiscuitShortbread,
 		BiscuitGingerNut,
+		BiscuitBourbon,
 	}
 }
 
@@ -24,13 +27,14 @@ func validBiscuits() []Biscuit {
 		BiscuitJammieDodger,
 		BiscuitShortbread,
 		BiscuitGingerNut,
+		BiscuitBourbon,
 	}
 }
 
 func ToBiscuit(value int) Biscuit {
 	biscuit_enum := Biscuit(value)
 	switch biscuit_enum {
-	case BiscuitDigestive, BiscuitHobnob, BiscuitNice, BiscuitJammieDodger, BiscuitShortbread, BiscuitGingerNut:
+	case BiscuitDigestive, BiscuitHobnob, BiscuitNice, BiscuitJammieDodger, BiscuitShortbread, BiscuitGingerNut, BiscuitBourbon:
 		return biscuit_enum
 	default:
 		return BiscuitDigestive
@@ -51,6 +55,8 @@ func (biscuit_enum Biscuit) String() string {
 		return "shortbread"
 	case BiscuitGingerNut:
 		return "ginger_nut"
+	case BiscuitBourbon:
+		return "bourbon"
 	default:
 		return BiscuitDigestive.String()
 	}
@@ -64,7 +70,54 @@ func BiscuitFromString(val string) (*Biscuit, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("%s is not a valid Biscuit", val)
+	return nil, invalidBiscuitError(val)
+}
+
+func BiscuitFromValue(value int) (*Biscuit, error) {
+	valid := validBiscuits()
+	for i := range valid {
+		if valid[i] == Biscuit(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidBiscuitError(value)
+}
+
+// invalidBiscuitError returns an *enum.InvalidValueError for the input,
+// listing the valid Biscuit values.
+func invalidBiscuitError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Biscuit",
+		Input:   fmt.Sprint(input),
+		Allowed: biscuitNames(),
+	}
+}
+
+// Values returns the string representations of the valid Biscuit values, it
+// implements the ent EnumValues interface.
+func (Biscuit) Values() []string {
+	return biscuitNames()
+}
+
+// Parse parses the string representation of a Biscuit, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Biscuit) Parse(str string) (Biscuit, error) {
+	enum, err := BiscuitFromString(str)
+	if err != nil {
+		return Biscuit(0), err
+	}
+
+	return *enum, nil
+}
+
+func biscuitNames() []string {
+	valid := validBiscuits()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
 }
 
 func (biscuit_enum Biscuit) Validate() error {
@@ -0,0 +1,104 @@
+// Code generated by go-enum, DO NOT EDIT.
+package multiple
+
+import (
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+var (
+	tBiscuit      = reflect.TypeOf((*Biscuit)(nil)).Elem()
+	tBiscuitValue = reflect.TypeOf((*int)(nil)).Elem()
+)
+
+// BiscuitCodec encodes and decodes Biscuit for a mongo-driver registry. It
+// stores the string representation of the enum, or the underlying value when
+// UseValue is set. Decoding accepts both representations.
+type BiscuitCodec struct {
+	UseValue bool
+}
+
+func (c BiscuitCodec) EncodeValue(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tBiscuit {
+		return bsoncodec.ValueEncoderError{Name: "BiscuitCodec.EncodeValue", Types: []reflect.Type{tBiscuit}, Received: val}
+	}
+
+	biscuit_enum := val.Interface().(Biscuit)
+	if err := biscuit_enum.Validate(); err != nil {
+		return err
+	}
+
+	if !c.UseValue {
+		return vw.WriteString(biscuit_enum.String())
+	}
+
+	encoder, err := ec.LookupEncoder(tBiscuitValue)
+	if err != nil {
+		return err
+	}
+
+	return encoder.EncodeValue(ec, vw, reflect.ValueOf(int(biscuit_enum)))
+}
+
+func (c BiscuitCodec) DecodeValue(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tBiscuit {
+		return bsoncodec.ValueDecoderError{Name: "BiscuitCodec.DecodeValue", Types: []reflect.Type{tBiscuit}, Received: val}
+	}
+
+	var enum *Biscuit
+	var err error
+
+	if vr.Type() == bsontype.String {
+		var str string
+		if str, err = vr.ReadString(); err != nil {
+			return err
+		}
+
+		enum, err = BiscuitFromString(str)
+	} else {
+		var decoder bsoncodec.ValueDecoder
+		if decoder, err = dc.LookupDecoder(tBiscuitValue); err != nil {
+			return err
+		}
+
+		value := reflect.New(tBiscuitValue).Elem()
+		if err = decoder.DecodeValue(dc, vr, value); err != nil {
+			return err
+		}
+
+		enum, err = BiscuitFromValue(value.Interface().(int))
+	}
+	if err != nil {
+		return err
+	}
+
+	val.Set(reflect.ValueOf(*enum))
+	return nil
+}
+
+func (biscuit_enum Biscuit) MarshalKey() (string, error) {
+	err := biscuit_enum.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	return biscuit_enum.String(), nil
+}
+
+func (biscuit_enum *Biscuit) UnmarshalKey(key string) error {
+	enum, err := BiscuitFromString(key)
+	if err != nil {
+		return err
+	}
+
+	*biscuit_enum = *enum
+	return nil
+}
+
+// RegisterBiscuitCodec registers the BiscuitCodec for Biscuit with the registry builder.
+func RegisterBiscuitCodec(rb *bsoncodec.RegistryBuilder) {
+	rb.RegisterCodec(tBiscuit, BiscuitCodec{UseValue: false})
+}
@@ -0,0 +1,31 @@
+// Code generated by go-enum, DO NOT EDIT.
+package multiple
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+func (Biscuit) GormDataType() string {
+	return "string"
+}
+
+// GormDBDataType uses the native enum type of postgres, quoted as generated
+// by -sql-ddl=postgres, and an enum column for mysql.
+func (Biscuit) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return `"biscuit"`
+	case "mysql":
+		valid := validBiscuits()
+		values := make([]string, len(valid))
+		for i := range valid {
+			values[i] = "'" + strings.ReplaceAll(valid[i].String(), "'", "''") + "'"
+		}
+		return "ENUM(" + strings.Join(values, ", ") + ")"
+	}
+
+	return ""
+}
@@ -3,6 +3,11 @@ package multiple
 
 
 func (biscuit_enum Biscuit) MarshalText() ([]byte, error) {
+	err := biscuit_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return []byte(biscuit_enum.String()), nil
 }
 
@@ -1,4 +1,4 @@
-//go:generate go run --mod=mod github.com/klippa-app/go-enum -case=pascal -gql=full -json -bson -xml -ent
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -case=pascal -gql=full -json -bson -xml -ent -bson-codec -sql-ddl=mysql
 package multiple
 
 type Cookie int
@@ -9,4 +9,5 @@ const (
 	ChocolateFinger
 	JaffaCake
 	ChocolateHobnob
+	ChocolateOrange
 )
@@ -0,0 +1,104 @@
+// Code generated by go-enum, DO NOT EDIT.
+package multiple
+
+import (
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+var (
+	tCookie      = reflect.TypeOf((*Cookie)(nil)).Elem()
+	tCookieValue = reflect.TypeOf((*int)(nil)).Elem()
+)
+
+// CookieCodec encodes and decodes Cookie for a mongo-driver registry. It
+// stores the string representation of the enum, or the underlying value when
+// UseValue is set. Decoding accepts both representations.
+type CookieCodec struct {
+	UseValue bool
+}
+
+func (c CookieCodec) EncodeValue(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tCookie {
+		return bsoncodec.ValueEncoderError{Name: "CookieCodec.EncodeValue", Types: []reflect.Type{tCookie}, Received: val}
+	}
+
+	cookie_enum := val.Interface().(Cookie)
+	if err := cookie_enum.Validate(); err != nil {
+		return err
+	}
+
+	if !c.UseValue {
+		return vw.WriteString(cookie_enum.String())
+	}
+
+	encoder, err := ec.LookupEncoder(tCookieValue)
+	if err != nil {
+		return err
+	}
+
+	return encoder.EncodeValue(ec, vw, reflect.ValueOf(int(cookie_enum)))
+}
+
+func (c CookieCodec) DecodeValue(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tCookie {
+		return bsoncodec.ValueDecoderError{Name: "CookieCodec.DecodeValue", Types: []reflect.Type{tCookie}, Received: val}
+	}
+
+	var enum *Cookie
+	var err error
+
+	if vr.Type() == bsontype.String {
+		var str string
+		if str, err = vr.ReadString(); err != nil {
+			return err
+		}
+
+		enum, err = CookieFromString(str)
+	} else {
+		var decoder bsoncodec.ValueDecoder
+		if decoder, err = dc.LookupDecoder(tCookieValue); err != nil {
+			return err
+		}
+
+		value := reflect.New(tCookieValue).Elem()
+		if err = decoder.DecodeValue(dc, vr, value); err != nil {
+			return err
+		}
+
+		enum, err = CookieFromValue(value.Interface().(int))
+	}
+	if err != nil {
+		return err
+	}
+
+	val.Set(reflect.ValueOf(*enum))
+	return nil
+}
+
+func (cookie_enum Cookie) MarshalKey() (string, error) {
+	err := cookie_enum.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	return cookie_enum.String(), nil
+}
+
+func (cookie_enum *Cookie) UnmarshalKey(key string) error {
+	enum, err := CookieFromString(key)
+	if err != nil {
+		return err
+	}
+
+	*cookie_enum = *enum
+	return nil
+}
+
+// RegisterCookieCodec registers the CookieCodec for Cookie with the registry builder.
+func RegisterCookieCodec(rb *bsoncodec.RegistryBuilder) {
+	rb.RegisterCodec(tCookie, CookieCodec{UseValue: false})
+}
@@ -0,0 +1,63 @@
+package multiple_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/multiple"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+)
+
+type jar struct {
+	Cookies []multiple.Cookie
+	Count   map[multiple.Cookie]int
+}
+
+func TestCookieCodec(t *testing.T) {
+	rb := bson.NewRegistryBuilder()
+	multiple.RegisterEnumCodecs(rb)
+	names := rb.Build()
+
+	values := bson.NewRegistryBuilder().
+		RegisterCodec(reflect.TypeOf(multiple.Cookie(0)), multiple.CookieCodec{UseValue: true}).
+		Build()
+
+	input := jar{
+		Cookies: []multiple.Cookie{multiple.JaffaCake, multiple.ChocolateFinger},
+		Count:   map[multiple.Cookie]int{multiple.ChocolateHobnob: 2},
+	}
+
+	tests := []struct {
+		registry *bsoncodec.Registry
+		want     string
+	}{
+		{registry: names, want: `{"cookies": ["JaffaCake","ChocolateFinger"],"count": {"ChocolateHobnob": {"$numberInt":"2"}}}`},
+		{registry: values, want: `{"cookies": [{"$numberInt":"8"},{"$numberInt":"4"}],"count": {"ChocolateHobnob": {"$numberInt":"2"}}}`},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		data, err := bson.MarshalWithRegistry(test.registry, input)
+		if err != nil {
+			t.Fatal("expected no error got:", err)
+		}
+
+		if got := bson.Raw(data).String(); got != test.want {
+			t.Error("expected", test.want, "got", got)
+		}
+
+		// Both registries decode either representation.
+		for _, registry := range []*bsoncodec.Registry{names, values} {
+			var res jar
+			if err := bson.UnmarshalWithRegistry(registry, data, &res); err != nil {
+				t.Fatal("expected no error got:", err)
+			}
+
+			if !reflect.DeepEqual(res, input) {
+				t.Error("expected", input, "got", res)
+			}
+		}
+	}
+}
@@ -1,11 +1,9 @@
 // Code generated by go-enum, DO NOT EDIT.
 package multiple
 
-func (Cookie) Values() []string {
-	valid := validCookies()
-	var values []string
-	for i := range valid {
-		values = append(values, valid[i].String())
-	}
-	return values
-}
+import (
+	"entgo.io/ent/schema/field"
+)
+
+// Cookie is used as an ent enum through its Values method.
+var _ field.EnumValues = Cookie(0)
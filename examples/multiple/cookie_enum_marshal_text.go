@@ -3,6 +3,11 @@ package multiple
 
 
 func (cookie_enum Cookie) MarshalText() ([]byte, error) {
+	err := cookie_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return []byte(cookie_enum.String()), nil
 }
 
@@ -0,0 +1,5 @@
+-- Code generated by go-enum, DO NOT EDIT.
+-- mysql declares the values on each column, run this for every cookie column,
+-- replacing `table` and `column`. Adding values at the end is an instant change.
+-- Added 'ChocolateOrange' after 'ChocolateHobnob'.
+ALTER TABLE `table` MODIFY COLUMN `column` ENUM('ChocolateDigestive', 'ChocolateShortbread', 'ChocolateFinger', 'JaffaCake', 'ChocolateHobnob', 'ChocolateOrange');
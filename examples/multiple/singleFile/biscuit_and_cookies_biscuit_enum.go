@@ -3,6 +3,8 @@ package singlefile
 
 import (
 	"fmt"
+
+	"github.com/klippa-app/go-enum/enum"
 )
 
 func AllBiscuits() []Biscuit {
@@ -64,7 +66,54 @@ func BiscuitFromString(val string) (*Biscuit, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("%s is not a valid Biscuit", val)
+	return nil, invalidBiscuitError(val)
+}
+
+func BiscuitFromValue(value int) (*Biscuit, error) {
+	valid := validBiscuits()
+	for i := range valid {
+		if valid[i] == Biscuit(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidBiscuitError(value)
+}
+
+// invalidBiscuitError returns an *enum.InvalidValueError for the input,
+// listing the valid Biscuit values.
+func invalidBiscuitError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Biscuit",
+		Input:   fmt.Sprint(input),
+		Allowed: biscuitNames(),
+	}
+}
+
+// Values returns the string representations of the valid Biscuit values, it
+// implements the ent EnumValues interface.
+func (Biscuit) Values() []string {
+	return biscuitNames()
+}
+
+// Parse parses the string representation of a Biscuit, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Biscuit) Parse(str string) (Biscuit, error) {
+	enum, err := BiscuitFromString(str)
+	if err != nil {
+		return Biscuit(0), err
+	}
+
+	return *enum, nil
+}
+
+func biscuitNames() []string {
+	valid := validBiscuits()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
 }
 
 func (biscuit_enum Biscuit) Validate() error {
@@ -2,6 +2,8 @@
 package singlefile
 
 import (
+	"fmt"
+
 	"github.com/globalsign/mgo/bson"
 
 	mongo "go.mongodb.org/mongo-driver/bson"
@@ -38,18 +40,28 @@ func (biscuit_enum *Biscuit) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
-func (biscuit_enum *Biscuit) UnmarshalBSON(data []byte) error {
-	return biscuit_enum.SetBSON(bson.Raw{
-		Kind: bson.ElementString,
-		Data: data,
-	})
-}
-
 func (biscuit_enum Biscuit) MarshalBSONValue() (bsontype.Type, []byte, error) {
 	err := biscuit_enum.Validate()
 	if err != nil {
-		return bsontype.Undefined,nil, err
+		return bsontype.Undefined, nil, err
 	}
 
 	return mongo.MarshalValue(biscuit_enum.String())
 }
+
+func (biscuit_enum *Biscuit) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	str, ok := raw.StringValueOK()
+	if !ok {
+		return fmt.Errorf("cannot unmarshal BSON %s into Biscuit", raw.Type)
+	}
+
+	enum, err := BiscuitFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*biscuit_enum = *enum
+	return nil
+}
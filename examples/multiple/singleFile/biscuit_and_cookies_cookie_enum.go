@@ -3,6 +3,8 @@ package singlefile
 
 import (
 	"fmt"
+
+	"github.com/klippa-app/go-enum/enum"
 )
 
 func AllCookies() []Cookie {
@@ -60,7 +62,54 @@ func CookieFromString(val string) (*Cookie, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("%s is not a valid Cookie", val)
+	return nil, invalidCookieError(val)
+}
+
+func CookieFromValue(value int) (*Cookie, error) {
+	valid := validCookies()
+	for i := range valid {
+		if valid[i] == Cookie(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidCookieError(value)
+}
+
+// invalidCookieError returns an *enum.InvalidValueError for the input,
+// listing the valid Cookie values.
+func invalidCookieError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Cookie",
+		Input:   fmt.Sprint(input),
+		Allowed: cookieNames(),
+	}
+}
+
+// Values returns the string representations of the valid Cookie values, it
+// implements the ent EnumValues interface.
+func (Cookie) Values() []string {
+	return cookieNames()
+}
+
+// Parse parses the string representation of a Cookie, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Cookie) Parse(str string) (Cookie, error) {
+	enum, err := CookieFromString(str)
+	if err != nil {
+		return Cookie(0), err
+	}
+
+	return *enum, nil
+}
+
+func cookieNames() []string {
+	valid := validCookies()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
 }
 
 func (cookie_enum Cookie) Validate() error {
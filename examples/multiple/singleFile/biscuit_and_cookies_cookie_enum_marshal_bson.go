@@ -2,6 +2,8 @@
 package singlefile
 
 import (
+	"fmt"
+
 	"github.com/globalsign/mgo/bson"
 
 	mongo "go.mongodb.org/mongo-driver/bson"
@@ -38,18 +40,28 @@ func (cookie_enum *Cookie) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
-func (cookie_enum *Cookie) UnmarshalBSON(data []byte) error {
-	return cookie_enum.SetBSON(bson.Raw{
-		Kind: bson.ElementString,
-		Data: data,
-	})
-}
-
 func (cookie_enum Cookie) MarshalBSONValue() (bsontype.Type, []byte, error) {
 	err := cookie_enum.Validate()
 	if err != nil {
-		return bsontype.Undefined,nil, err
+		return bsontype.Undefined, nil, err
 	}
 
 	return mongo.MarshalValue(cookie_enum.String())
 }
+
+func (cookie_enum *Cookie) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	str, ok := raw.StringValueOK()
+	if !ok {
+		return fmt.Errorf("cannot unmarshal BSON %s into Cookie", raw.Type)
+	}
+
+	enum, err := CookieFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*cookie_enum = *enum
+	return nil
+}
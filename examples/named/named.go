@@ -1,4 +1,4 @@
-//go:generate go run --mod=mod github.com/klippa-app/go-enum -name=Day -case=kebab -gql=full -json -bson -xml -ent -text
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -name=Day -case=kebab -gql=full -gql-schema-dir=../schema -json -bson=mongo-v2 -bson-codec -xml -ent=field -text -flag -predicates
 package day
 
 type Day int
@@ -2,31 +2,27 @@
 package day
 
 import (
-	"github.com/globalsign/mgo/bson"
+	"fmt"
 
-	mongo "go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/bsontype"
+	mongo "go.mongodb.org/mongo-driver/v2/bson"
 )
 
-func (day_enum Day) GetBSON() (interface{}, error) {
-	err := day_enum.Validate() 
+func (day_enum Day) MarshalBSONValue() (byte, []byte, error) {
+	err := day_enum.Validate()
 	if err != nil {
-		return nil, err
+		return byte(mongo.TypeUndefined), nil, err
 	}
 
-	return day_enum.String(), nil
+	t, data, err := mongo.MarshalValue(day_enum.String())
+	return byte(t), data, err
 }
 
-func (day_enum *Day) SetBSON(raw bson.Raw) error {
-	var str string
+func (day_enum *Day) UnmarshalBSONValue(t byte, data []byte) error {
+	raw := mongo.RawValue{Type: mongo.Type(t), Value: data}
 
-	if len(raw.Data) == 0 {
-		return bson.ErrSetZero
-	}
-	
-	err := raw.Unmarshal(&str)
-	if err != nil {
-		return err
+	str, ok := raw.StringValueOK()
+	if !ok {
+		return fmt.Errorf("cannot unmarshal BSON %s into Day", raw.Type)
 	}
 
 	enum, err := DayFromString(str)
@@ -37,19 +33,3 @@ func (day_enum *Day) SetBSON(raw bson.Raw) error {
 	*day_enum = *enum
 	return nil
 }
-
-func (day_enum *Day) UnmarshalBSON(data []byte) error {
-	return day_enum.SetBSON(bson.Raw{
-		Kind: bson.ElementString,
-		Data: data,
-	})
-}
-
-func (day_enum Day) MarshalBSONValue() (bsontype.Type, []byte, error) {
-	err := day_enum.Validate()
-	if err != nil {
-		return bsontype.Undefined,nil, err
-	}
-
-	return mongo.MarshalValue(day_enum.String())
-}
@@ -0,0 +1,104 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var (
+	tDay      = reflect.TypeOf((*Day)(nil)).Elem()
+	tDayValue = reflect.TypeOf((*int)(nil)).Elem()
+)
+
+// DayCodec encodes and decodes Day for a mongo-driver registry. It
+// stores the string representation of the enum, or the underlying value when
+// UseValue is set. Decoding accepts both representations.
+type DayCodec struct {
+	UseValue bool
+}
+
+func (c DayCodec) EncodeValue(ec bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tDay {
+		return bson.ValueEncoderError{Name: "DayCodec.EncodeValue", Types: []reflect.Type{tDay}, Received: val}
+	}
+
+	day_enum := val.Interface().(Day)
+	if err := day_enum.Validate(); err != nil {
+		return err
+	}
+
+	if !c.UseValue {
+		return vw.WriteString(day_enum.String())
+	}
+
+	encoder, err := ec.LookupEncoder(tDayValue)
+	if err != nil {
+		return err
+	}
+
+	return encoder.EncodeValue(ec, vw, reflect.ValueOf(int(day_enum)))
+}
+
+func (c DayCodec) DecodeValue(dc bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tDay {
+		return bson.ValueDecoderError{Name: "DayCodec.DecodeValue", Types: []reflect.Type{tDay}, Received: val}
+	}
+
+	var enum *Day
+	var err error
+
+	if vr.Type() == bson.TypeString {
+		var str string
+		if str, err = vr.ReadString(); err != nil {
+			return err
+		}
+
+		enum, err = DayFromString(str)
+	} else {
+		var decoder bson.ValueDecoder
+		if decoder, err = dc.LookupDecoder(tDayValue); err != nil {
+			return err
+		}
+
+		value := reflect.New(tDayValue).Elem()
+		if err = decoder.DecodeValue(dc, vr, value); err != nil {
+			return err
+		}
+
+		enum, err = DayFromValue(value.Interface().(int))
+	}
+	if err != nil {
+		return err
+	}
+
+	val.Set(reflect.ValueOf(*enum))
+	return nil
+}
+
+func (day_enum Day) MarshalKey() (string, error) {
+	err := day_enum.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	return day_enum.String(), nil
+}
+
+func (day_enum *Day) UnmarshalKey(key string) error {
+	enum, err := DayFromString(key)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
+
+// RegisterDayCodec registers the DayCodec for Day with the registry.
+func RegisterDayCodec(reg *bson.Registry) {
+	codec := DayCodec{UseValue: false}
+	reg.RegisterTypeEncoder(tDay, codec)
+	reg.RegisterTypeDecoder(tDay, codec)
+}
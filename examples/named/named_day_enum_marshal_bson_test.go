@@ -0,0 +1,43 @@
+package day_test
+
+import (
+	"testing"
+
+	day "github.com/klippa-app/go-enum/examples/named"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type marshallableStruct struct {
+	Dag day.Day
+}
+
+func TestDagBSON(t *testing.T) {
+	data, err := bson.Marshal(marshallableStruct{Dag: day.Wednesday})
+	if err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+
+	var res marshallableStruct
+	if err := bson.Unmarshal(data, &res); err != nil {
+		t.Fatal("expected no error got:", err)
+	}
+	if res.Dag != day.Wednesday {
+		t.Error("invalid day", res.Dag, "expected:", day.Wednesday)
+	}
+
+	if _, err := bson.Marshal(marshallableStruct{Dag: day.Unknown}); err == nil {
+		t.Error("expected an error marshalling an invalid day")
+	}
+
+	invalid := []interface{}{"Monday", int32(1), true}
+	for i := range invalid {
+		data, err := bson.Marshal(bson.M{"dag": invalid[i]})
+		if err != nil {
+			t.Fatal("expected no error got:", err)
+		}
+
+		if err := bson.Unmarshal(data, &res); err == nil {
+			t.Error("expected an error unmarshalling", invalid[i])
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Code generated by go-enum, DO NOT EDIT.
+package day
+
+import (
+	"flag"
+)
+
+// Set implements flag.Value, only accepting valid Day values.
+func (day_enum *Day) Set(str string) error {
+	enum, err := DayFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*day_enum = *enum
+	return nil
+}
+
+// Type returns the name of the type shown in the usage of the flag.
+func (day_enum *Day) Type() string {
+	return "day"
+}
+
+// DayFlag defines a Day flag with the specified name, default value
+// and usage string, the returned pointer stores the value of the flag.
+func DayFlag(fs *flag.FlagSet, name string, value Day, usage string) *Day {
+	p := new(Day)
+	*p = value
+	fs.Var(p, name, usage)
+	return p
+}
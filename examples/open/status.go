@@ -0,0 +1,11 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -json -bson=mongo -xml -yaml -open
+package open
+
+type Status int
+
+const (
+	Unknown Status = iota //enum:invalid
+	Pending
+	Active
+	Closed
+)
@@ -0,0 +1,35 @@
+// Code generated by go-enum, DO NOT EDIT.
+package open
+
+import (
+	"fmt"
+
+	mongo "go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func (status_enum Status) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	err := status_enum.Validate()
+	if err != nil {
+		return bsontype.Undefined, nil, err
+	}
+
+	return mongo.MarshalValue(status_enum.String())
+}
+
+func (status_enum *Status) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	str, ok := raw.StringValueOK()
+	if !ok {
+		return fmt.Errorf("cannot unmarshal BSON %s into Status", raw.Type)
+	}
+
+	enum, err := StatusFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*status_enum = *enum
+	return nil
+}
@@ -0,0 +1,29 @@
+// Code generated by go-enum, DO NOT EDIT.
+package open
+
+import (
+	"strconv"
+	"strings"
+)
+
+func (status_enum Status) MarshalJSON() ([]byte, error) {
+	err := status_enum.Validate() 
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strconv.Quote(status_enum.String())), nil
+}
+
+func (status_enum *Status) UnmarshalJSON(val []byte) error {
+	str := string(val)
+	str = strings.Trim(str, "\"")
+
+	enum, err := StatusFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*status_enum = *enum
+	return nil
+}
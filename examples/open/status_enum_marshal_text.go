@@ -0,0 +1,23 @@
+// Code generated by go-enum, DO NOT EDIT.
+package open
+
+
+func (status_enum Status) MarshalText() ([]byte, error) {
+	err := status_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(status_enum.String()), nil
+}
+
+func (status_enum *Status) UnmarshalText(text []byte) (error) {
+	enum, err := StatusFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*status_enum = *enum
+
+	return nil
+}
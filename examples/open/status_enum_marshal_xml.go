@@ -0,0 +1,30 @@
+// Code generated by go-enum, DO NOT EDIT.
+package open
+
+import (
+	"encoding/xml"
+)
+
+func (status_enum Status) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	err := status_enum.Validate() 
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeElement(status_enum.String(), start)
+}
+
+func (status_enum *Status) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	enum, err := StatusFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*status_enum = *enum
+	return nil
+}
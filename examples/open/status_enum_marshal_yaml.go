@@ -0,0 +1,31 @@
+// Code generated by go-enum, DO NOT EDIT.
+package open
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+func (status_enum Status) MarshalYAML() (interface{}, error) {
+	err := status_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return status_enum.String(), nil
+}
+
+func (status_enum *Status) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d, column %d: cannot unmarshal a non scalar node into Status", node.Line, node.Column)
+	}
+
+	enum, err := StatusFromString(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d, column %d: %w", node.Line, node.Column, err)
+	}
+
+	*status_enum = *enum
+	return nil
+}
@@ -0,0 +1,106 @@
+// Code generated by go-enum, DO NOT EDIT.
+package open
+
+import (
+	"fmt"
+
+	mongo "go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// OpenStatus holds a Status, or an unknown string in place of it, so values
+// added by a newer producer are preserved and marshaled verbatim.
+type OpenStatus struct {
+	Status Status
+	// Unrecognized is the unknown string, Status is ignored when it is set.
+	Unrecognized string
+}
+
+// IsKnown reports whether openstatus_enum holds a valid Status.
+func (openstatus_enum OpenStatus) IsKnown() bool {
+	return openstatus_enum.Unrecognized == "" && openstatus_enum.Status.Validate() == nil
+}
+
+// IsUnrecognized reports whether openstatus_enum holds an unknown string.
+func (openstatus_enum OpenStatus) IsUnrecognized() bool {
+	return openstatus_enum.Unrecognized != ""
+}
+
+// Validate reports unknown strings and invalid Status values as an
+// *enum.InvalidValueError.
+func (openstatus_enum OpenStatus) Validate() error {
+	if openstatus_enum.IsUnrecognized() {
+		return invalidStatusError(openstatus_enum.Unrecognized)
+	}
+
+	return openstatus_enum.Status.Validate()
+}
+
+func (openstatus_enum OpenStatus) String() string {
+	if openstatus_enum.IsUnrecognized() {
+		return openstatus_enum.Unrecognized
+	}
+
+	return openstatus_enum.Status.String()
+}
+
+// MarshalText implements encoding.TextMarshaler, which is also used by
+// encoding/json, encoding/xml and yaml.v3.
+func (openstatus_enum OpenStatus) MarshalText() ([]byte, error) {
+	if openstatus_enum.IsUnrecognized() {
+		return []byte(openstatus_enum.Unrecognized), nil
+	}
+
+	err := openstatus_enum.Status.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(openstatus_enum.Status.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, unknown strings are
+// preserved rather than rejected.
+func (openstatus_enum *OpenStatus) UnmarshalText(text []byte) error {
+	str := string(text)
+
+	enum, err := StatusFromString(str)
+	if err == nil {
+		*openstatus_enum = OpenStatus{Status: *enum}
+		return nil
+	}
+
+	// The empty string and the names of the declared invalid values are not
+	// unknown.
+	if str == "" {
+		return err
+	}
+	for _, v := range AllStatuses() {
+		if v.String() == str {
+			return err
+		}
+	}
+
+	*openstatus_enum = OpenStatus{Unrecognized: str}
+	return nil
+}
+
+func (openstatus_enum OpenStatus) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	text, err := openstatus_enum.MarshalText()
+	if err != nil {
+		return bsontype.Undefined, nil, err
+	}
+
+	return mongo.MarshalValue(string(text))
+}
+
+func (openstatus_enum *OpenStatus) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	str, ok := raw.StringValueOK()
+	if !ok {
+		return fmt.Errorf("cannot unmarshal BSON %s into OpenStatus", raw.Type)
+	}
+
+	return openstatus_enum.UnmarshalText([]byte(str))
+}
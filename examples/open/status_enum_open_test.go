@@ -0,0 +1,117 @@
+package open_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/open"
+	"go.mongodb.org/mongo-driver/bson"
+	"gopkg.in/yaml.v3"
+)
+
+type document struct {
+	Status open.OpenStatus `json:"status" bson:"status" xml:"status" yaml:"status"`
+}
+
+func TestStatusOpenJSON(t *testing.T) {
+	var doc document
+	if err := json.Unmarshal([]byte(`{"status":"archived"}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Status.IsKnown() || !doc.Status.IsUnrecognized() {
+		t.Errorf("expected an unrecognized status, got %v", doc.Status)
+	}
+	if doc.Status.Unrecognized != "archived" {
+		t.Errorf("expected the unknown string to be stored, got %q", doc.Status.Unrecognized)
+	}
+	var invalid *enum.InvalidValueError
+	if err := doc.Status.Validate(); !errors.Is(err, enum.ErrInvalid) || !errors.As(err, &invalid) || invalid.Input != "archived" {
+		t.Errorf("expected Validate to report the unrecognized status, got %v", err)
+	}
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"status":"archived"}` {
+		t.Errorf("expected the status verbatim, got %s", out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"status":"active"}`), &doc); err != nil || doc.Status.Status != open.Active || !doc.Status.IsKnown() {
+		t.Errorf("expected active, got %v, %v", doc.Status, err)
+	}
+	if err := doc.Status.Validate(); err != nil {
+		t.Errorf("expected active to be valid, got %v", err)
+	}
+}
+
+func TestStatusOpenBSON(t *testing.T) {
+	data, err := bson.Marshal(bson.M{"status": "reopened"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc document
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw bson.M
+	if err := bson.Unmarshal(out, &raw); err != nil || raw["status"] != "reopened" {
+		t.Errorf("expected the status verbatim, got %v, %v", raw, err)
+	}
+}
+
+func TestStatusOpenYAML(t *testing.T) {
+	var doc document
+	if err := yaml.Unmarshal([]byte("status: deleted"), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil || string(out) != "status: deleted\n" {
+		t.Errorf("expected the status verbatim, got %q, %v", out, err)
+	}
+}
+
+func TestStatusOpenXML(t *testing.T) {
+	var doc document
+	if err := xml.Unmarshal([]byte("<document><status>merged</status></document>"), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := xml.Marshal(doc)
+	if err != nil || string(out) != "<document><status>merged</status></document>" {
+		t.Errorf("expected the status verbatim, got %s, %v", out, err)
+	}
+}
+
+func TestStatusDeclaredInvalid(t *testing.T) {
+	// Declared invalid values are still rejected.
+	if _, err := json.Marshal(document{Status: open.OpenStatus{Status: open.Unknown}}); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an error marshaling an invalid status, got %v", err)
+	}
+	for _, input := range []string{`{"status":"unknown"}`, `{"status":""}`} {
+		var doc document
+		if err := json.Unmarshal([]byte(input), &doc); !errors.Is(err, enum.ErrInvalid) {
+			t.Errorf("expected an error unmarshaling %s, got %v", input, err)
+		}
+	}
+}
+
+func TestStatusClosed(t *testing.T) {
+	// The marshalers of Status itself still reject unknown strings.
+	var status open.Status
+	if err := json.Unmarshal([]byte(`"archived"`), &status); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an error unmarshaling an unknown status, got %v", err)
+	}
+}
@@ -0,0 +1,13 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -diagram=dot
+package order
+
+type Payment int
+
+const (
+	PaymentUnknown    Payment = iota //enum:invalid
+	PaymentPending                   //enum:to=PaymentAuthorized,PaymentFailed
+	PaymentAuthorized                //enum:to=PaymentCaptured,PaymentVoided
+	PaymentCaptured
+	PaymentFailed //enum:to=PaymentPending
+	PaymentVoided
+)
@@ -0,0 +1,121 @@
+// Code generated by go-enum, DO NOT EDIT.
+package order
+
+import (
+	"fmt"
+
+	"github.com/klippa-app/go-enum/enum"
+)
+
+func AllPayments() []Payment {
+	return []Payment{
+		PaymentUnknown,
+		PaymentPending,
+		PaymentAuthorized,
+		PaymentCaptured,
+		PaymentFailed,
+		PaymentVoided,
+	}
+}
+
+func validPayments() []Payment {
+	return []Payment{
+		PaymentPending,
+		PaymentAuthorized,
+		PaymentCaptured,
+		PaymentFailed,
+		PaymentVoided,
+	}
+}
+
+func ToPayment(value int) Payment {
+	payment_enum := Payment(value)
+	switch payment_enum {
+	case PaymentUnknown, PaymentPending, PaymentAuthorized, PaymentCaptured, PaymentFailed, PaymentVoided:
+		return payment_enum
+	default:
+		panic(fmt.Sprintf("no default for enum %v", payment_enum))
+	}
+}
+
+func (payment_enum Payment) String() string {
+	switch payment_enum {
+	case PaymentUnknown:
+		return "unknown"
+	case PaymentPending:
+		return "pending"
+	case PaymentAuthorized:
+		return "authorized"
+	case PaymentCaptured:
+		return "captured"
+	case PaymentFailed:
+		return "failed"
+	case PaymentVoided:
+		return "voided"
+	default:
+		panic(fmt.Sprintf("no default for enum %T, invalid value: '%#v'", payment_enum, payment_enum))
+	}
+}
+
+func PaymentFromString(val string) (*Payment, error) {
+	valid := validPayments()
+	for i := range valid {
+		if valid[i].String() == val {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidPaymentError(val)
+}
+
+func PaymentFromValue(value int) (*Payment, error) {
+	valid := validPayments()
+	for i := range valid {
+		if valid[i] == Payment(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidPaymentError(value)
+}
+
+// invalidPaymentError returns an *enum.InvalidValueError for the input,
+// listing the valid Payment values.
+func invalidPaymentError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Payment",
+		Input:   fmt.Sprint(input),
+		Allowed: paymentNames(),
+	}
+}
+
+// Values returns the string representations of the valid Payment values, it
+// implements the ent EnumValues interface.
+func (Payment) Values() []string {
+	return paymentNames()
+}
+
+// Parse parses the string representation of a Payment, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Payment) Parse(str string) (Payment, error) {
+	enum, err := PaymentFromString(str)
+	if err != nil {
+		return Payment(0), err
+	}
+
+	return *enum, nil
+}
+
+func paymentNames() []string {
+	valid := validPayments()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
+}
+
+func (payment_enum Payment) Validate() error {
+	_, err := PaymentFromString(payment_enum.String())
+	return err
+}
@@ -0,0 +1,63 @@
+// Code generated by go-enum, DO NOT EDIT.
+package order
+
+import (
+	"github.com/klippa-app/go-enum/enum"
+)
+
+// InitialPayment returns the state a Payment starts in.
+func InitialPayment() Payment {
+	return PaymentPending
+}
+
+// Successors returns the values payment_enum can transition to.
+func (payment_enum Payment) Successors() []Payment {
+	switch payment_enum {
+	case PaymentPending:
+		return []Payment{PaymentAuthorized, PaymentFailed}
+	case PaymentAuthorized:
+		return []Payment{PaymentCaptured, PaymentVoided}
+	case PaymentFailed:
+		return []Payment{PaymentPending}
+	default:
+		return nil
+	}
+}
+
+// CanTransitionTo reports whether payment_enum can transition to next.
+func (payment_enum Payment) CanTransitionTo(next Payment) bool {
+	for _, successor := range payment_enum.Successors() {
+		if successor == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transition returns next when payment_enum can transition to it, or an
+// *enum.InvalidTransitionError.
+func (payment_enum Payment) Transition(next Payment) (Payment, error) {
+	if _, err := PaymentFromValue(int(payment_enum)); err != nil {
+		return payment_enum, err
+	}
+	if _, err := PaymentFromValue(int(next)); err != nil {
+		return payment_enum, err
+	}
+
+	if !payment_enum.CanTransitionTo(next) {
+		return payment_enum, &enum.InvalidTransitionError{
+			Enum: "Payment",
+			From: payment_enum.String(),
+			To:   next.String(),
+		}
+	}
+
+	return next, nil
+}
+
+// IsTerminal reports whether payment_enum is a valid value without successors.
+func (payment_enum Payment) IsTerminal() bool {
+	_, err := PaymentFromValue(int(payment_enum))
+	return err == nil && len(payment_enum.Successors()) == 0
+}
@@ -0,0 +1,24 @@
+package order_test
+
+import (
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/order"
+)
+
+func TestPaymentRetry(t *testing.T) {
+	payment := order.InitialPayment()
+	for _, next := range []order.Payment{order.PaymentFailed, order.PaymentPending, order.PaymentAuthorized, order.PaymentCaptured} {
+		var err error
+		if payment, err = payment.Transition(next); err != nil {
+			t.Fatalf("Transition(%v) = %v", next, err)
+		}
+	}
+
+	if !payment.IsTerminal() {
+		t.Errorf("expected %v to be terminal", payment)
+	}
+	if order.PaymentFailed.IsTerminal() {
+		t.Error("expected a failed payment to be retried")
+	}
+}
@@ -0,0 +1,14 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -json -diagram=mermaid -atomic -test
+package order
+
+type Status int
+
+const (
+	Unknown Status = iota //enum:invalid
+	Created               //enum:to=Paid,Cancelled
+	Paid                  //enum:to=Shipped,Refunded
+	Shipped               //enum:to=Delivered
+	Delivered
+	Cancelled
+	Refunded
+)
@@ -0,0 +1,62 @@
+// Code generated by go-enum, DO NOT EDIT.
+package order
+
+import (
+	"sync/atomic"
+)
+
+// AtomicStatus is a Status that can be loaded and stored concurrently.
+// The zero value holds the zero value of Status.
+type AtomicStatus struct {
+	value int64
+}
+
+// Load returns the current value.
+func (a *AtomicStatus) Load() Status {
+	return decodeAtomicStatus(atomic.LoadInt64(&a.value))
+}
+
+// Store stores value, or returns an error when it is invalid.
+func (a *AtomicStatus) Store(value Status) error {
+	word, err := encodeAtomicStatus(value)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(&a.value, word)
+	return nil
+}
+
+// Swap stores value and returns the previous value, or returns an error when
+// value is invalid.
+func (a *AtomicStatus) Swap(value Status) (Status, error) {
+	word, err := encodeAtomicStatus(value)
+	if err != nil {
+		return Status(0), err
+	}
+
+	return decodeAtomicStatus(atomic.SwapInt64(&a.value, word)), nil
+}
+
+// CompareAndSwap stores new when the current value is old, or returns an error
+// when new is invalid.
+func (a *AtomicStatus) CompareAndSwap(old, new Status) (bool, error) {
+	word, err := encodeAtomicStatus(new)
+	if err != nil {
+		return false, err
+	}
+
+	return atomic.CompareAndSwapInt64(&a.value, int64(old), word), nil
+}
+
+func encodeAtomicStatus(value Status) (int64, error) {
+	if _, err := StatusFromValue(int(value)); err != nil {
+		return 0, err
+	}
+
+	return int64(value), nil
+}
+
+func decodeAtomicStatus(word int64) Status {
+	return Status(word)
+}
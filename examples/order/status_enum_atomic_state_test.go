@@ -0,0 +1,38 @@
+package order_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/order"
+)
+
+func TestAtomicStatus(t *testing.T) {
+	var status order.AtomicStatus
+	if status.Load() != order.Unknown {
+		t.Errorf("Load() = %v, want the zero value", status.Load())
+	}
+
+	if err := status.Store(order.Status(42)); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+	if err := status.Store(order.Created); err != nil {
+		t.Fatal(err)
+	}
+
+	if swapped, err := status.CompareAndSwap(order.Paid, order.Shipped); err != nil || swapped {
+		t.Errorf("CompareAndSwap(Paid, Shipped) = %v, %v, want false", swapped, err)
+	}
+	if swapped, err := status.CompareAndSwap(order.Created, order.Paid); err != nil || !swapped {
+		t.Errorf("CompareAndSwap(Created, Paid) = %v, %v, want true", swapped, err)
+	}
+	if _, err := status.CompareAndSwap(order.Paid, order.Unknown); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+
+	old, err := status.Swap(order.Shipped)
+	if err != nil || old != order.Paid || status.Load() != order.Shipped {
+		t.Errorf("Swap(Shipped) = %v, %v, want Paid", old, err)
+	}
+}
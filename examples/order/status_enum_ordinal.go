@@ -0,0 +1,83 @@
+// Code generated by go-enum, DO NOT EDIT.
+package order
+
+import (
+	"github.com/klippa-app/go-enum/enum"
+)
+
+// Ordinal returns the position of status_enum among the valid Status values, or
+// -1 when it is invalid.
+func (status_enum Status) Ordinal() int {
+	switch status_enum {
+	case Created:
+		return 0
+	case Paid:
+		return 1
+	case Shipped:
+		return 2
+	case Delivered:
+		return 3
+	case Cancelled:
+		return 4
+	case Refunded:
+		return 5
+	default:
+		return -1
+	}
+}
+
+func StatusFromOrdinal(ordinal int) (Status, error) {
+	valid := validStatuses()
+	if ordinal < 0 || ordinal >= len(valid) {
+		return Status(0), &enum.OrdinalRangeError{
+			Enum:    "Status",
+			Ordinal: ordinal,
+			Len:     len(valid),
+		}
+	}
+
+	return valid[ordinal], nil
+}
+
+// Next returns the valid value following status_enum, false when status_enum is the
+// last or an invalid value.
+func (status_enum Status) Next() (Status, bool) {
+	ordinal := status_enum.Ordinal()
+	if ordinal < 0 {
+		return status_enum, false
+	}
+
+	next, err := StatusFromOrdinal(ordinal + 1)
+	return next, err == nil
+}
+
+// Prev returns the valid value preceding status_enum, false when status_enum is the
+// first or an invalid value.
+func (status_enum Status) Prev() (Status, bool) {
+	ordinal := status_enum.Ordinal()
+	if ordinal < 0 {
+		return status_enum, false
+	}
+
+	prev, err := StatusFromOrdinal(ordinal - 1)
+	return prev, err == nil
+}
+
+// Compare returns -1, 0 or 1 when status_enum is ordered before, equal to or
+// after other. Invalid values are ordered before the valid values, by their
+// underlying value.
+func (status_enum Status) Compare(other Status) int {
+	a, b := status_enum.Ordinal(), other.Ordinal()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case a >= 0 || status_enum == other:
+		return 0
+	case status_enum < other:
+		return -1
+	default:
+		return 1
+	}
+}
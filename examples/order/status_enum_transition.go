@@ -0,0 +1,63 @@
+// Code generated by go-enum, DO NOT EDIT.
+package order
+
+import (
+	"github.com/klippa-app/go-enum/enum"
+)
+
+// InitialStatus returns the state a Status starts in.
+func InitialStatus() Status {
+	return Created
+}
+
+// Successors returns the values status_enum can transition to.
+func (status_enum Status) Successors() []Status {
+	switch status_enum {
+	case Created:
+		return []Status{Paid, Cancelled}
+	case Paid:
+		return []Status{Shipped, Refunded}
+	case Shipped:
+		return []Status{Delivered}
+	default:
+		return nil
+	}
+}
+
+// CanTransitionTo reports whether status_enum can transition to next.
+func (status_enum Status) CanTransitionTo(next Status) bool {
+	for _, successor := range status_enum.Successors() {
+		if successor == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transition returns next when status_enum can transition to it, or an
+// *enum.InvalidTransitionError.
+func (status_enum Status) Transition(next Status) (Status, error) {
+	if _, err := StatusFromValue(int(status_enum)); err != nil {
+		return status_enum, err
+	}
+	if _, err := StatusFromValue(int(next)); err != nil {
+		return status_enum, err
+	}
+
+	if !status_enum.CanTransitionTo(next) {
+		return status_enum, &enum.InvalidTransitionError{
+			Enum: "Status",
+			From: status_enum.String(),
+			To:   next.String(),
+		}
+	}
+
+	return next, nil
+}
+
+// IsTerminal reports whether status_enum is a valid value without successors.
+func (status_enum Status) IsTerminal() bool {
+	_, err := StatusFromValue(int(status_enum))
+	return err == nil && len(status_enum.Successors()) == 0
+}
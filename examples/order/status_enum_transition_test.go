@@ -0,0 +1,57 @@
+package order_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/order"
+)
+
+func TestStatusTransition(t *testing.T) {
+	if initial := order.InitialStatus(); initial != order.Created {
+		t.Errorf("InitialStatus() = %v, want Created", initial)
+	}
+
+	next, err := order.Created.Transition(order.Paid)
+	if err != nil || next != order.Paid {
+		t.Errorf("Created.Transition(Paid) = %v, %v, want Paid", next, err)
+	}
+
+	next, err = order.Created.Transition(order.Shipped)
+	if next != order.Created {
+		t.Errorf("Created.Transition(Shipped) = %v, want Created", next)
+	}
+	var transition *enum.InvalidTransitionError
+	if !errors.As(err, &transition) || !errors.Is(err, enum.ErrInvalidTransition) {
+		t.Fatalf("expected an *enum.InvalidTransitionError, got %v", err)
+	}
+	if transition.From != "created" || transition.To != "shipped" {
+		t.Errorf("unexpected transition error %+v", transition)
+	}
+
+	if _, err := order.Unknown.Transition(order.Paid); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+}
+
+func TestStatusSuccessors(t *testing.T) {
+	if successors := order.Paid.Successors(); !reflect.DeepEqual(successors, []order.Status{order.Shipped, order.Refunded}) {
+		t.Errorf("Paid.Successors() = %v", successors)
+	}
+	if !order.Shipped.CanTransitionTo(order.Delivered) || order.Delivered.CanTransitionTo(order.Shipped) {
+		t.Error("unexpected CanTransitionTo result")
+	}
+
+	for _, s := range []order.Status{order.Delivered, order.Cancelled, order.Refunded} {
+		if !s.IsTerminal() {
+			t.Errorf("expected %v to be terminal", s)
+		}
+	}
+	for _, s := range []order.Status{order.Unknown, order.Created, order.Paid} {
+		if s.IsTerminal() {
+			t.Errorf("expected %v not to be terminal", s)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -sql=value -pgx
+package pgx
+
+type Level int8
+
+const (
+	Debug Level = iota - 1
+	Info
+	Warn
+	Error
+)
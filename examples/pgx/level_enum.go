@@ -0,0 +1,130 @@
+// Code generated by go-enum, DO NOT EDIT.
+package pgx
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/klippa-app/go-enum/enum"
+)
+
+func AllLevels() []Level {
+	return []Level{
+		Debug,
+		Info,
+		Warn,
+		Error,
+	}
+}
+
+func validLevels() []Level {
+	return []Level{
+		Debug,
+		Info,
+		Warn,
+		Error,
+	}
+}
+
+func ToLevel(value int8) Level {
+	level_enum := Level(value)
+	switch level_enum {
+	case Debug, Info, Warn, Error:
+		return level_enum
+	default:
+		panic(fmt.Sprintf("no default for enum %v", level_enum))
+	}
+}
+
+func (level_enum Level) String() string {
+	switch level_enum {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	case Error:
+		return "error"
+	default:
+		panic(fmt.Sprintf("no default for enum %T, invalid value: '%#v'", level_enum, level_enum))
+	}
+}
+
+func LevelFromString(val string) (*Level, error) {
+	valid := validLevels()
+	for i := range valid {
+		if valid[i].String() == val {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidLevelError(val)
+}
+
+func LevelFromValue(value int8) (*Level, error) {
+	valid := validLevels()
+	for i := range valid {
+		if valid[i] == Level(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidLevelError(value)
+}
+
+// parseLevelValue accepts both the string representation and the underlying
+// value of the enum, so stored data can be migrated between the two.
+func parseLevelValue(str string) (*Level, error) {
+	if enum, err := LevelFromString(str); err == nil {
+		return enum, nil
+	}
+
+	value, err := strconv.ParseInt(str, 10, 8)
+	if err != nil {
+		return nil, invalidLevelError(str)
+	}
+
+	return LevelFromValue(int8(value))
+}
+
+// invalidLevelError returns an *enum.InvalidValueError for the input,
+// listing the valid Level values.
+func invalidLevelError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Level",
+		Input:   fmt.Sprint(input),
+		Allowed: levelNames(),
+	}
+}
+
+// Values returns the string representations of the valid Level values, it
+// implements the ent EnumValues interface.
+func (Level) Values() []string {
+	return levelNames()
+}
+
+// Parse parses the string representation of a Level, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Level) Parse(str string) (Level, error) {
+	enum, err := LevelFromString(str)
+	if err != nil {
+		return Level(0), err
+	}
+
+	return *enum, nil
+}
+
+func levelNames() []string {
+	valid := validLevels()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
+}
+
+func (level_enum Level) Validate() error {
+	_, err := LevelFromString(level_enum.String())
+	return err
+}
@@ -0,0 +1,34 @@
+// Code generated by go-enum, DO NOT EDIT.
+package pgx
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (level_enum Level) Int64Value() (pgtype.Int8, error) {
+	err := level_enum.Validate()
+	if err != nil {
+		return pgtype.Int8{}, err
+	}
+
+	return pgtype.Int8{Int64: int64(level_enum), Valid: true}, nil
+}
+
+func (level_enum *Level) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		return fmt.Errorf("cannot scan NULL into Level")
+	}
+	if int64(int8(v.Int64)) != v.Int64 {
+		return invalidLevelError(v.Int64)
+	}
+
+	enum, err := LevelFromValue(int8(v.Int64))
+	if err != nil {
+		return err
+	}
+
+	*level_enum = *enum
+	return nil
+}
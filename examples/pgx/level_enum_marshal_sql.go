@@ -0,0 +1,37 @@
+// Code generated by go-enum, DO NOT EDIT.
+package pgx
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+func (level_enum Level) Value() (driver.Value, error) {
+	return int64(level_enum), level_enum.Validate()
+}
+
+func (level_enum *Level) Scan(val any) error {
+	var enum *Level
+	var err error
+
+	switch v := val.(type) {
+	case string:
+		enum, err = parseLevelValue(v)
+	case []byte:
+		enum, err = parseLevelValue(string(v))
+	case int64:
+		if int64(int8(v)) != v {
+			return invalidLevelError(v)
+		}
+
+		enum, err = LevelFromValue(int8(v))
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+	if err != nil {
+		return err
+	}
+
+	*level_enum = *enum
+	return nil
+}
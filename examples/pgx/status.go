@@ -0,0 +1,10 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -sql -pgx
+package pgx
+
+type Status int
+
+const (
+	Unknown Status = iota //enum:invalid
+	Active
+	Suspended
+)
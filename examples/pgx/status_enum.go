@@ -0,0 +1,109 @@
+// Code generated by go-enum, DO NOT EDIT.
+package pgx
+
+import (
+	"fmt"
+
+	"github.com/klippa-app/go-enum/enum"
+)
+
+func AllStatuses() []Status {
+	return []Status{
+		Unknown,
+		Active,
+		Suspended,
+	}
+}
+
+func validStatuses() []Status {
+	return []Status{
+		Active,
+		Suspended,
+	}
+}
+
+func ToStatus(value int) Status {
+	status_enum := Status(value)
+	switch status_enum {
+	case Unknown, Active, Suspended:
+		return status_enum
+	default:
+		panic(fmt.Sprintf("no default for enum %v", status_enum))
+	}
+}
+
+func (status_enum Status) String() string {
+	switch status_enum {
+	case Unknown:
+		return "unknown"
+	case Active:
+		return "active"
+	case Suspended:
+		return "suspended"
+	default:
+		panic(fmt.Sprintf("no default for enum %T, invalid value: '%#v'", status_enum, status_enum))
+	}
+}
+
+func StatusFromString(val string) (*Status, error) {
+	valid := validStatuses()
+	for i := range valid {
+		if valid[i].String() == val {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidStatusError(val)
+}
+
+func StatusFromValue(value int) (*Status, error) {
+	valid := validStatuses()
+	for i := range valid {
+		if valid[i] == Status(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidStatusError(value)
+}
+
+// invalidStatusError returns an *enum.InvalidValueError for the input,
+// listing the valid Status values.
+func invalidStatusError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Status",
+		Input:   fmt.Sprint(input),
+		Allowed: statusNames(),
+	}
+}
+
+// Values returns the string representations of the valid Status values, it
+// implements the ent EnumValues interface.
+func (Status) Values() []string {
+	return statusNames()
+}
+
+// Parse parses the string representation of a Status, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Status) Parse(str string) (Status, error) {
+	enum, err := StatusFromString(str)
+	if err != nil {
+		return Status(0), err
+	}
+
+	return *enum, nil
+}
+
+func statusNames() []string {
+	valid := validStatuses()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
+}
+
+func (status_enum Status) Validate() error {
+	_, err := StatusFromString(status_enum.String())
+	return err
+}
@@ -0,0 +1,31 @@
+// Code generated by go-enum, DO NOT EDIT.
+package pgx
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (status_enum Status) TextValue() (pgtype.Text, error) {
+	err := status_enum.Validate()
+	if err != nil {
+		return pgtype.Text{}, err
+	}
+
+	return pgtype.Text{String: status_enum.String(), Valid: true}, nil
+}
+
+func (status_enum *Status) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		return fmt.Errorf("cannot scan NULL into Status")
+	}
+
+	enum, err := StatusFromString(v.String)
+	if err != nil {
+		return err
+	}
+
+	*status_enum = *enum
+	return nil
+}
@@ -0,0 +1,72 @@
+package pgx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/pgx"
+)
+
+func TestStatusPgxText(t *testing.T) {
+	m := pgtype.NewMap()
+
+	buf, err := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, pgx.Suspended, nil)
+	if err != nil || string(buf) != "suspended" {
+		t.Errorf("Encode(Suspended) = %s, %v", buf, err)
+	}
+	// pgx does not wrap the errors of the encoders.
+	if _, err := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, pgx.Unknown, nil); err == nil || !strings.Contains(err.Error(), "unknown is not a valid Status") {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+
+	var status pgx.Status
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte("active"), &status); err != nil || status != pgx.Active {
+		t.Errorf("Scan(active) = %v, %v", status, err)
+	}
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte("deleted"), &status); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, nil, &status); err == nil {
+		t.Error("expected an error for NULL")
+	}
+}
+
+func TestLevelPgxInt64(t *testing.T) {
+	m := pgtype.NewMap()
+
+	buf, err := m.Encode(pgtype.Int8OID, pgtype.TextFormatCode, pgx.Debug, nil)
+	if err != nil || string(buf) != "-1" {
+		t.Errorf("Encode(Debug) = %s, %v", buf, err)
+	}
+
+	tests := []struct {
+		input   []byte
+		want    pgx.Level
+		wantErr bool
+	}{
+		{input: []byte("2"), want: pgx.Error},
+		{input: []byte("3"), wantErr: true},
+		// 257 would wrap around to the valid Warn in an int8.
+		{input: []byte("257"), wantErr: true},
+		{input: nil, wantErr: true},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		var level pgx.Level
+		err := m.Scan(pgtype.Int8OID, pgtype.TextFormatCode, test.input, &level)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("Scan(%s): expected an error", test.input)
+			}
+			continue
+		}
+		if err != nil || level != test.want {
+			t.Errorf("Scan(%s) = %v, %v, want %v", test.input, level, err, test.want)
+		}
+	}
+}
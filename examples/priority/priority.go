@@ -0,0 +1,14 @@
+//go:generate go run --mod=mod github.com/klippa-app/go-enum -json=name -bson=value,mongo -xml=value -sql=value -yaml=value -toml=value -msgpack=value -cbor=value -binary=value -set=array -map -register -gorm
+package priority
+
+type Priority uint8
+
+const (
+	// Low can wait until there is time.
+	Low Priority = iota + 1
+	Medium
+	// High has to be handled today.
+	High
+	// Critical has to be handled right away.
+	Critical
+)
@@ -0,0 +1,130 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/klippa-app/go-enum/enum"
+)
+
+func AllPriorities() []Priority {
+	return []Priority{
+		Low,
+		Medium,
+		High,
+		Critical,
+	}
+}
+
+func validPriorities() []Priority {
+	return []Priority{
+		Low,
+		Medium,
+		High,
+		Critical,
+	}
+}
+
+func ToPriority(value uint8) Priority {
+	priority_enum := Priority(value)
+	switch priority_enum {
+	case Low, Medium, High, Critical:
+		return priority_enum
+	default:
+		panic(fmt.Sprintf("no default for enum %v", priority_enum))
+	}
+}
+
+func (priority_enum Priority) String() string {
+	switch priority_enum {
+	case Low:
+		return "low"
+	case Medium:
+		return "medium"
+	case High:
+		return "high"
+	case Critical:
+		return "critical"
+	default:
+		panic(fmt.Sprintf("no default for enum %T, invalid value: '%#v'", priority_enum, priority_enum))
+	}
+}
+
+func PriorityFromString(val string) (*Priority, error) {
+	valid := validPriorities()
+	for i := range valid {
+		if valid[i].String() == val {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidPriorityError(val)
+}
+
+func PriorityFromValue(value uint8) (*Priority, error) {
+	valid := validPriorities()
+	for i := range valid {
+		if valid[i] == Priority(value) {
+			return &valid[i], nil
+		}
+	}
+
+	return nil, invalidPriorityError(value)
+}
+
+// parsePriorityValue accepts both the string representation and the underlying
+// value of the enum, so stored data can be migrated between the two.
+func parsePriorityValue(str string) (*Priority, error) {
+	if enum, err := PriorityFromString(str); err == nil {
+		return enum, nil
+	}
+
+	value, err := strconv.ParseUint(str, 10, 8)
+	if err != nil {
+		return nil, invalidPriorityError(str)
+	}
+
+	return PriorityFromValue(uint8(value))
+}
+
+// invalidPriorityError returns an *enum.InvalidValueError for the input,
+// listing the valid Priority values.
+func invalidPriorityError(input interface{}) error {
+	return &enum.InvalidValueError{
+		Enum:    "Priority",
+		Input:   fmt.Sprint(input),
+		Allowed: priorityNames(),
+	}
+}
+
+// Values returns the string representations of the valid Priority values, it
+// implements the ent EnumValues interface.
+func (Priority) Values() []string {
+	return priorityNames()
+}
+
+// Parse parses the string representation of a Priority, the receiver is
+// ignored so it can be called through the zero value by generic code.
+func (Priority) Parse(str string) (Priority, error) {
+	enum, err := PriorityFromString(str)
+	if err != nil {
+		return Priority(0), err
+	}
+
+	return *enum, nil
+}
+
+func priorityNames() []string {
+	valid := validPriorities()
+	values := make([]string, len(valid))
+	for i := range valid {
+		values[i] = valid[i].String()
+	}
+	return values
+}
+
+func (priority_enum Priority) Validate() error {
+	_, err := PriorityFromString(priority_enum.String())
+	return err
+}
@@ -0,0 +1,66 @@
+package priority_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/priority"
+)
+
+func TestPriorityMap(t *testing.T) {
+	var m priority.PriorityMap[int]
+	if err := m.Set(priority.Critical, 3); err != nil {
+		t.Fatal(err)
+	}
+	_ = m.Set(priority.Low, 1)
+	_ = m.Set(priority.Medium, 2)
+
+	if err := m.Set(priority.Priority(0), 0); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+
+	if value, ok := m.Get(priority.Critical); !ok || value != 3 {
+		t.Errorf("Get(Critical) = %d, %v, want 3", value, ok)
+	}
+	if _, ok := m.Get(priority.High); ok {
+		t.Error("expected High not to be set")
+	}
+
+	m.Delete(priority.Medium)
+	if m.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", m.Len())
+	}
+
+	var keys []priority.Priority
+	m.Range(func(key priority.Priority, value int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if len(keys) != 2 || keys[0] != priority.Low || keys[1] != priority.Critical {
+		t.Errorf("Range() visited %v, want [low critical]", keys)
+	}
+}
+
+func TestPriorityMapJSON(t *testing.T) {
+	var m priority.PriorityMap[string]
+	_ = m.Set(priority.High, "today")
+	_ = m.Set(priority.Low, "later")
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"low":"later","high":"today"}` {
+		t.Errorf("unexpected json %s", data)
+	}
+
+	var res priority.PriorityMap[string]
+	if err := json.Unmarshal(data, &res); err != nil || res != m {
+		t.Errorf("json.Unmarshal() = %v, want %v", err, m)
+	}
+	if err := json.Unmarshal([]byte(`{"urgent":"now"}`), &res); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+}
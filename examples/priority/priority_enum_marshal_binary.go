@@ -0,0 +1,43 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+func (priority_enum Priority) MarshalBinary() ([]byte, error) {
+	err := priority_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutUvarint(buf, uint64(priority_enum))], nil
+}
+
+func (priority_enum *Priority) UnmarshalBinary(data []byte) error {
+	v, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("invalid binary Priority value")
+	}
+	if uint64(uint8(v)) != v {
+		return invalidPriorityError(v)
+	}
+
+	enum, err := PriorityFromValue(uint8(v))
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+	return nil
+}
+
+func (priority_enum Priority) GobEncode() ([]byte, error) {
+	return priority_enum.MarshalBinary()
+}
+
+func (priority_enum *Priority) GobDecode(data []byte) error {
+	return priority_enum.UnmarshalBinary(data)
+}
@@ -0,0 +1,52 @@
+package priority_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/priority"
+)
+
+func TestPriorityBinary(t *testing.T) {
+	data, err := priority.Critical.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, []byte{4}) {
+		t.Errorf("data = %v, want [4]", data)
+	}
+
+	tests := []struct {
+		input   []byte
+		want    priority.Priority
+		wantErr bool
+	}{
+		{input: []byte{2}, want: priority.Medium},
+		{input: []byte{0}, wantErr: true},
+		{input: []byte{0x83, 0x02}, wantErr: true}, // 259
+		{input: []byte{}, wantErr: true},
+		{input: []byte{1, 1}, wantErr: true},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		var res priority.Priority
+		err := res.UnmarshalBinary(test.input)
+		if test.wantErr {
+			if err == nil {
+				t.Error("expected an error for", test.input, "got", res)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Error("expected no error got:", err)
+			continue
+		}
+
+		if res != test.want {
+			t.Error("expected", test.want, "got", res)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	mongo "go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func (priority_enum Priority) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	err := priority_enum.Validate()
+	if err != nil {
+		return bsontype.Undefined, nil, err
+	}
+
+	return mongo.MarshalValue(uint8(priority_enum))
+}
+
+func (priority_enum *Priority) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	var enum *Priority
+	var err error
+
+	if raw.Type == bsontype.String {
+		enum, err = parsePriorityValue(raw.StringValue())
+	} else {
+		var value uint8
+		if err = raw.Unmarshal(&value); err != nil {
+			return err
+		}
+
+		enum, err = PriorityFromValue(value)
+	}
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+	return nil
+}
@@ -0,0 +1,38 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"github.com/fxamacker/cbor/v2"
+)
+
+func (priority_enum Priority) MarshalCBOR() ([]byte, error) {
+	err := priority_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return cbor.Marshal(uint8(priority_enum))
+}
+
+func (priority_enum *Priority) UnmarshalCBOR(data []byte) error {
+	var enum *Priority
+	var err error
+
+	var str string
+	if cbor.Unmarshal(data, &str) == nil {
+		enum, err = parsePriorityValue(str)
+	} else {
+		var value uint8
+		if err = cbor.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		enum, err = PriorityFromValue(value)
+	}
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+	return nil
+}
@@ -0,0 +1,29 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"strconv"
+	"strings"
+)
+
+func (priority_enum Priority) MarshalJSON() ([]byte, error) {
+	err := priority_enum.Validate() 
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strconv.Quote(priority_enum.String())), nil
+}
+
+func (priority_enum *Priority) UnmarshalJSON(val []byte) error {
+	str := string(val)
+	str = strings.Trim(str, "\"")
+
+	enum, err := PriorityFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+	return nil
+}
@@ -0,0 +1,52 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+func (priority_enum Priority) EncodeMsgpack(enc *msgpack.Encoder) error {
+	err := priority_enum.Validate()
+	if err != nil {
+		return err
+	}
+
+	return enc.Encode(uint8(priority_enum))
+}
+
+func (priority_enum *Priority) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+
+	var enum *Priority
+	if msgpcode.IsString(code) {
+		str, err := dec.DecodeString()
+		if err != nil {
+			return err
+		}
+
+		enum, err = parsePriorityValue(str)
+		if err != nil {
+			return err
+		}
+	} else {
+		v, err := dec.DecodeInt64()
+		if err != nil {
+			return err
+		}
+		if int64(uint8(v)) != v {
+			return invalidPriorityError(v)
+		}
+
+		enum, err = PriorityFromValue(uint8(v))
+		if err != nil {
+			return err
+		}
+	}
+
+	*priority_enum = *enum
+	return nil
+}
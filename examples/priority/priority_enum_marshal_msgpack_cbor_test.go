@@ -0,0 +1,76 @@
+package priority_test
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/klippa-app/go-enum/examples/priority"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type priorityCodec struct {
+	name      string
+	marshal   func(v interface{}) ([]byte, error)
+	unmarshal func(data []byte, v interface{}) error
+}
+
+var priorityCodecs = []priorityCodec{
+	{name: "msgpack", marshal: msgpack.Marshal, unmarshal: msgpack.Unmarshal},
+	{name: "cbor", marshal: cbor.Marshal, unmarshal: cbor.Unmarshal},
+}
+
+func TestPriorityBinaryCodecs(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		want    priority.Priority
+		wantErr bool
+	}{
+		{input: 3, want: priority.High},
+		{input: "4", want: priority.Critical},
+		{input: "medium", want: priority.Medium},
+		{input: 0, wantErr: true},
+		{input: 259, wantErr: true},
+		{input: "urgent", wantErr: true},
+		{input: true, wantErr: true},
+	}
+
+	for _, codec := range priorityCodecs {
+		for i := range tests {
+			test := tests[i]
+
+			data, err := codec.marshal(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var res priority.Priority
+			err = codec.unmarshal(data, &res)
+			if test.wantErr {
+				if err == nil {
+					t.Error(codec.name, "expected an error for", test.input, "got", res)
+				}
+				continue
+			}
+
+			if err != nil {
+				t.Error(codec.name, "expected no error got:", err)
+				continue
+			}
+
+			if res != test.want {
+				t.Error(codec.name, "expected", test.want, "got", res)
+			}
+		}
+
+		// The underlying value is stored.
+		data, err := codec.marshal(priority.Low)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var value uint8
+		if err := codec.unmarshal(data, &value); err != nil || value != 1 {
+			t.Error(codec.name, "expected the underlying value, got", value, err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+func (priority_enum Priority) Value() (driver.Value, error) {
+	return int64(priority_enum), priority_enum.Validate()
+}
+
+func (priority_enum *Priority) Scan(val any) error {
+	var enum *Priority
+	var err error
+
+	switch v := val.(type) {
+	case string:
+		enum, err = parsePriorityValue(v)
+	case []byte:
+		enum, err = parsePriorityValue(string(v))
+	case int64:
+		if int64(uint8(v)) != v {
+			return invalidPriorityError(v)
+		}
+
+		enum, err = PriorityFromValue(uint8(v))
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+	return nil
+}
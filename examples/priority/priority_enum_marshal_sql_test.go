@@ -0,0 +1,54 @@
+package priority_test
+
+import (
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/priority"
+)
+
+func TestPrioritySQL(t *testing.T) {
+	tests := []struct {
+		input   any
+		want    priority.Priority
+		wantErr bool
+	}{
+		{input: int64(3), want: priority.High},
+		{input: []byte("4"), want: priority.Critical},
+		{input: "medium", want: priority.Medium},
+		{input: []byte("low"), want: priority.Low},
+		{input: int64(0), wantErr: true},
+		{input: int64(259), wantErr: true},
+		{input: "urgent", wantErr: true},
+		{input: 1.5, wantErr: true},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		var res priority.Priority
+		err := res.Scan(test.input)
+		if test.wantErr {
+			if err == nil {
+				t.Error("expected an error for", test.input, "got", res)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Error("expected no error got:", err)
+			continue
+		}
+
+		if res != test.want {
+			t.Error("invalid priority", res, "expected:", test.want)
+		}
+	}
+
+	value, err := priority.High.Value()
+	if err != nil {
+		t.Error("expected no error got:", err)
+	}
+	if value != int64(3) {
+		t.Error("expected the underlying value, got:", value)
+	}
+}
@@ -0,0 +1,23 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+
+func (priority_enum Priority) MarshalText() ([]byte, error) {
+	err := priority_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(priority_enum.String()), nil
+}
+
+func (priority_enum *Priority) UnmarshalText(text []byte) (error) {
+	enum, err := PriorityFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"fmt"
+)
+
+// MarshalTOML implements the BurntSushi/toml Marshaler interface, other
+// libraries use the text marshalers.
+func (priority_enum Priority) MarshalTOML() ([]byte, error) {
+	err := priority_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprint(uint8(priority_enum))), nil
+}
+
+// UnmarshalTOML implements the BurntSushi/toml Unmarshaler interface.
+func (priority_enum *Priority) UnmarshalTOML(val interface{}) error {
+	var enum *Priority
+	var err error
+
+	switch v := val.(type) {
+	case string:
+		enum, err = parsePriorityValue(v)
+	case int64:
+		if int64(uint8(v)) != v {
+			return invalidPriorityError(v)
+		}
+
+		enum, err = PriorityFromValue(uint8(v))
+	default:
+		return fmt.Errorf("cannot unmarshal TOML %T into Priority", v)
+	}
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+	return nil
+}
@@ -0,0 +1,55 @@
+package priority_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/klippa-app/go-enum/examples/priority"
+)
+
+func TestPriorityTOML(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    priority.Priority
+		wantErr bool
+	}{
+		{input: "priority = 3", want: priority.High},
+		{input: "priority = \"4\"", want: priority.Critical},
+		{input: "priority = \"medium\"", want: priority.Medium},
+		{input: "priority = 0", wantErr: true},
+		{input: "priority = 259", wantErr: true},
+		{input: "priority = \"urgent\"", wantErr: true},
+		{input: "priority = 1.5", wantErr: true},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		var res struct{ Priority priority.Priority }
+		_, err := toml.Decode(test.input, &res)
+		if test.wantErr {
+			if err == nil {
+				t.Error("expected an error for", test.input, "got", res.Priority)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Error("expected no error got:", err)
+			continue
+		}
+
+		if res.Priority != test.want {
+			t.Error("expected", test.want, "got", res.Priority)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := toml.NewEncoder(&out).Encode(struct{ Priority priority.Priority }{priority.Low}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "Priority = 1\n" {
+		t.Errorf("expected the underlying value, got %q", out.String())
+	}
+}
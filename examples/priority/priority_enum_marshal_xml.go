@@ -0,0 +1,30 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"encoding/xml"
+)
+
+func (priority_enum Priority) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	err := priority_enum.Validate() 
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeElement(uint8(priority_enum), start)
+}
+
+func (priority_enum *Priority) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	enum, err := parsePriorityValue(str)
+	if err != nil {
+		return err
+	}
+
+	*priority_enum = *enum
+	return nil
+}
@@ -0,0 +1,31 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+func (priority_enum Priority) MarshalYAML() (interface{}, error) {
+	err := priority_enum.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return uint8(priority_enum), nil
+}
+
+func (priority_enum *Priority) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d, column %d: cannot unmarshal a non scalar node into Priority", node.Line, node.Column)
+	}
+
+	enum, err := parsePriorityValue(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d, column %d: %w", node.Line, node.Column, err)
+	}
+
+	*priority_enum = *enum
+	return nil
+}
@@ -0,0 +1,65 @@
+package priority_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/klippa-app/go-enum/examples/priority"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPriorityYAML(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    priority.Priority
+		wantErr bool
+	}{
+		{input: "priority: 3", want: priority.High},
+		{input: "priority: \"4\"", want: priority.Critical},
+		{input: "priority: medium", want: priority.Medium},
+		{input: "priority: 0", wantErr: true},
+		{input: "priority: urgent", wantErr: true},
+		{input: "priority: [1]", wantErr: true},
+	}
+
+	for i := range tests {
+		test := tests[i]
+
+		var res struct{ Priority priority.Priority }
+		err := yaml.Unmarshal([]byte(test.input), &res)
+		if test.wantErr {
+			if err == nil {
+				t.Error("expected an error for", test.input, "got", res.Priority)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Error("expected no error got:", err)
+			continue
+		}
+
+		if res.Priority != test.want {
+			t.Error("expected", test.want, "got", res.Priority)
+		}
+	}
+
+	out, err := yaml.Marshal(struct{ Priority priority.Priority }{priority.Low})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "priority: 1\n" {
+		t.Errorf("expected the underlying value, got %q", out)
+	}
+}
+
+func TestPriorityYAMLErrorPosition(t *testing.T) {
+	var res struct {
+		Name     string
+		Priority priority.Priority
+	}
+	err := yaml.Unmarshal([]byte("name: test\npriority: urgent"), &res)
+	if err == nil || !strings.Contains(err.Error(), "line 2, column 11") {
+		t.Errorf("expected an error on line 2, column 11, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"github.com/klippa-app/go-enum/enum"
+)
+
+// Ordinal returns the position of priority_enum among the valid Priority values, or
+// -1 when it is invalid.
+func (priority_enum Priority) Ordinal() int {
+	switch priority_enum {
+	case Low:
+		return 0
+	case Medium:
+		return 1
+	case High:
+		return 2
+	case Critical:
+		return 3
+	default:
+		return -1
+	}
+}
+
+func PriorityFromOrdinal(ordinal int) (Priority, error) {
+	valid := validPriorities()
+	if ordinal < 0 || ordinal >= len(valid) {
+		return Priority(0), &enum.OrdinalRangeError{
+			Enum:    "Priority",
+			Ordinal: ordinal,
+			Len:     len(valid),
+		}
+	}
+
+	return valid[ordinal], nil
+}
+
+// Next returns the valid value following priority_enum, false when priority_enum is the
+// last or an invalid value.
+func (priority_enum Priority) Next() (Priority, bool) {
+	ordinal := priority_enum.Ordinal()
+	if ordinal < 0 {
+		return priority_enum, false
+	}
+
+	next, err := PriorityFromOrdinal(ordinal + 1)
+	return next, err == nil
+}
+
+// Prev returns the valid value preceding priority_enum, false when priority_enum is the
+// first or an invalid value.
+func (priority_enum Priority) Prev() (Priority, bool) {
+	ordinal := priority_enum.Ordinal()
+	if ordinal < 0 {
+		return priority_enum, false
+	}
+
+	prev, err := PriorityFromOrdinal(ordinal - 1)
+	return prev, err == nil
+}
+
+// Compare returns -1, 0 or 1 when priority_enum is ordered before, equal to or
+// after other. Invalid values are ordered before the valid values, by their
+// underlying value.
+func (priority_enum Priority) Compare(other Priority) int {
+	a, b := priority_enum.Ordinal(), other.Ordinal()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case a >= 0 || priority_enum == other:
+		return 0
+	case priority_enum < other:
+		return -1
+	default:
+		return 1
+	}
+}
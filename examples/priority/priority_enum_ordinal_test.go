@@ -0,0 +1,61 @@
+package priority_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/priority"
+)
+
+func TestPriorityOrdinal(t *testing.T) {
+	for i, p := range priority.AllPriorities() {
+		if p.Ordinal() != i {
+			t.Errorf("%v.Ordinal() = %d, want %d", p, p.Ordinal(), i)
+		}
+
+		res, err := priority.PriorityFromOrdinal(i)
+		if err != nil || res != p {
+			t.Errorf("PriorityFromOrdinal(%d) = %v, %v, want %v", i, res, err, p)
+		}
+	}
+
+	if ordinal := priority.Priority(0).Ordinal(); ordinal != -1 {
+		t.Errorf("invalid ordinal = %d, want -1", ordinal)
+	}
+	if _, err := priority.PriorityFromOrdinal(4); !errors.Is(err, enum.ErrOrdinalRange) {
+		t.Errorf("PriorityFromOrdinal(4) error = %v, want enum.ErrOrdinalRange", err)
+	}
+	if _, err := priority.PriorityFromOrdinal(-1); !errors.Is(err, enum.ErrOrdinalRange) {
+		t.Errorf("PriorityFromOrdinal(-1) error = %v, want enum.ErrOrdinalRange", err)
+	}
+}
+
+func TestPriorityNextPrev(t *testing.T) {
+	if next, ok := priority.Medium.Next(); !ok || next != priority.High {
+		t.Errorf("Medium.Next() = %v, %v, want High", next, ok)
+	}
+	if _, ok := priority.Critical.Next(); ok {
+		t.Error("expected no value after Critical")
+	}
+	if prev, ok := priority.Medium.Prev(); !ok || prev != priority.Low {
+		t.Errorf("Medium.Prev() = %v, %v, want Low", prev, ok)
+	}
+	if _, ok := priority.Low.Prev(); ok {
+		t.Error("expected no value before Low")
+	}
+}
+
+func TestPriorityCompare(t *testing.T) {
+	if priority.Low.Compare(priority.High) != -1 || priority.High.Compare(priority.Low) != 1 || priority.High.Compare(priority.High) != 0 {
+		t.Error("expected the priorities to compare by their ordinal")
+	}
+
+	a, b := priority.Priority(0), priority.Priority(100)
+	if a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Error("expected different invalid priorities to compare by their value")
+	}
+	if a.Compare(priority.Low) != -1 || priority.Low.Compare(b) != 1 {
+		t.Error("expected invalid priorities before the valid priorities")
+	}
+}
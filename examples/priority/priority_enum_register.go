@@ -0,0 +1,34 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"github.com/klippa-app/go-enum/enum"
+)
+
+func init() {
+	enum.Register(enum.Definition{
+		Name:    "Priority",
+		Package: "github.com/klippa-app/go-enum/examples/priority",
+		Values: []enum.Value{
+			{
+				Name:        Low.String(),
+				Value:       uint8(Low),
+				Description: "Low can wait until there is time.",
+			},
+			{
+				Name:  Medium.String(),
+				Value: uint8(Medium),
+			},
+			{
+				Name:        High.String(),
+				Value:       uint8(High),
+				Description: "High has to be handled today.",
+			},
+			{
+				Name:        Critical.String(),
+				Value:       uint8(Critical),
+				Description: "Critical has to be handled right away.",
+			},
+		},
+	})
+}
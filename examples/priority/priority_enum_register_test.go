@@ -0,0 +1,62 @@
+package priority_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	_ "github.com/klippa-app/go-enum/examples/priority"
+)
+
+func TestPriorityRegister(t *testing.T) {
+	definition, ok := enum.Lookup("github.com/klippa-app/go-enum/examples/priority", "Priority")
+	if !ok {
+		t.Fatal("expected Priority to be registered")
+	}
+
+	want := []enum.Value{
+		{Name: "low", Value: uint8(1), Description: "Low can wait until there is time."},
+		{Name: "medium", Value: uint8(2)},
+		{Name: "high", Value: uint8(3), Description: "High has to be handled today."},
+		{Name: "critical", Value: uint8(4), Description: "Critical has to be handled right away."},
+	}
+	if !reflect.DeepEqual(definition.Values, want) {
+		t.Errorf("values = %#v, want %#v", definition.Values, want)
+	}
+
+	definition.Values[0].Name = "changed"
+	enum.Definitions()[0].Values[1].Name = "changed"
+	definition, _ = enum.Lookup("github.com/klippa-app/go-enum/examples/priority", "Priority")
+	if !reflect.DeepEqual(definition.Values, want) {
+		t.Errorf("registry modified through the returned definitions, values = %#v", definition.Values)
+	}
+}
+
+func TestPriorityRegistryHandler(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	enum.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/enums", nil))
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("content type = %s, want application/json", contentType)
+	}
+
+	var definitions []struct {
+		Name   string
+		Values []struct {
+			Name  string
+			Value int
+		}
+	}
+	if err := json.NewDecoder(recorder.Body).Decode(&definitions); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(definitions) != 1 || definitions[0].Name != "Priority" || len(definitions[0].Values) != 4 {
+		t.Errorf("unexpected definitions %+v", definitions)
+	}
+	if value := definitions[0].Values[3]; value.Name != "critical" || value.Value != 4 {
+		t.Errorf("unexpected value %+v", value)
+	}
+}
@@ -0,0 +1,184 @@
+// Code generated by go-enum, DO NOT EDIT.
+package priority
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/klippa-app/go-enum/enum"
+
+	mongo "go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// PrioritySet is a set of Priority values, stored as a bitset over their
+// ordinals. The zero value is an empty set.
+type PrioritySet struct {
+	bits [(4 + 63) / 64]uint64
+}
+
+// NewPrioritySet returns a set of the values, or an error when one of them is
+// invalid.
+func NewPrioritySet(values ...Priority) (PrioritySet, error) {
+	var set PrioritySet
+	err := set.Add(values...)
+	return set, err
+}
+
+// Add adds the values to the set, it adds none of them when one is invalid.
+func (set *PrioritySet) Add(values ...Priority) error {
+	for _, value := range values {
+		if value.Ordinal() < 0 {
+			return invalidPriorityError(uint8(value))
+		}
+	}
+
+	for _, value := range values {
+		ordinal := value.Ordinal()
+		set.bits[ordinal/64] |= 1 << (ordinal % 64)
+	}
+	return nil
+}
+
+// Remove removes the values from the set.
+func (set *PrioritySet) Remove(values ...Priority) {
+	for _, value := range values {
+		if ordinal := value.Ordinal(); ordinal >= 0 {
+			set.bits[ordinal/64] &^= 1 << (ordinal % 64)
+		}
+	}
+}
+
+// Has reports whether value is in the set.
+func (set PrioritySet) Has(value Priority) bool {
+	ordinal := value.Ordinal()
+	return ordinal >= 0 && set.bits[ordinal/64]&(1<<(ordinal%64)) != 0
+}
+
+// Union returns the values in either set.
+func (set PrioritySet) Union(other PrioritySet) PrioritySet {
+	for i := range set.bits {
+		set.bits[i] |= other.bits[i]
+	}
+	return set
+}
+
+// Intersect returns the values in both sets.
+func (set PrioritySet) Intersect(other PrioritySet) PrioritySet {
+	for i := range set.bits {
+		set.bits[i] &= other.bits[i]
+	}
+	return set
+}
+
+// Difference returns the values in set that are not in other.
+func (set PrioritySet) Difference(other PrioritySet) PrioritySet {
+	for i := range set.bits {
+		set.bits[i] &^= other.bits[i]
+	}
+	return set
+}
+
+// Len returns the number of values in the set.
+func (set PrioritySet) Len() int {
+	n := 0
+	for i := range set.bits {
+		n += bits.OnesCount64(set.bits[i])
+	}
+	return n
+}
+
+// Values returns the values in the set, in the order of their ordinals.
+func (set PrioritySet) Values() []Priority {
+	values := []Priority{}
+	for _, value := range validPriorities() {
+		if set.Has(value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// MarshalJSON marshals the set as an array of its values.
+func (set PrioritySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.Values())
+}
+
+func (set *PrioritySet) UnmarshalJSON(data []byte) error {
+	var values []Priority
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*set = PrioritySet{}
+	return set.Add(values...)
+}
+
+func (set PrioritySet) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return mongo.MarshalValue(set.Values())
+}
+
+func (set *PrioritySet) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := mongo.RawValue{Type: t, Value: data}
+
+	var values []Priority
+	if err := raw.Unmarshal(&values); err != nil {
+		return err
+	}
+
+	*set = PrioritySet{}
+	return set.Add(values...)
+}
+
+// Value stores the set as a postgres array, quoting elements
+// that contain separators, quotes or braces.
+func (set PrioritySet) Value() (driver.Value, error) {
+	values := set.Values()
+	parts := make([]string, len(values))
+	for i := range values {
+		value, err := values[i].Value()
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = enum.QuoteArrayElement(fmt.Sprint(value))
+	}
+
+	return "{" + strings.Join(parts, ",") + "}", nil
+}
+
+// Scan accepts both comma separated values and a postgres array, elements
+// may be quoted.
+func (set *PrioritySet) Scan(val any) error {
+	var str string
+
+	switch v := val.(type) {
+	case nil:
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+
+	*set = PrioritySet{}
+
+	parts, err := enum.SplitArray(str)
+	if err != nil {
+		return err
+	}
+
+	for _, part := range parts {
+		var value Priority
+		if err := value.Scan(part); err != nil {
+			return err
+		}
+		if err := set.Add(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package priority_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/klippa-app/go-enum/enum"
+	"github.com/klippa-app/go-enum/examples/priority"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestPrioritySet(t *testing.T) {
+	set, err := priority.NewPrioritySet(priority.Critical, priority.Low, priority.Critical)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Len() != 2 || !set.Has(priority.Low) || set.Has(priority.Medium) {
+		t.Errorf("unexpected set %v", set.Values())
+	}
+	if got, want := set.Values(), []priority.Priority{priority.Low, priority.Critical}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+
+	if err := set.Add(priority.High, priority.Priority(0)); !errors.Is(err, enum.ErrInvalid) {
+		t.Errorf("expected an invalid value error, got %v", err)
+	}
+	if set.Has(priority.High) {
+		t.Error("expected no values to be added when one is invalid")
+	}
+
+	other, _ := priority.NewPrioritySet(priority.Low, priority.Medium)
+	if got := set.Union(other).Values(); !reflect.DeepEqual(got, []priority.Priority{priority.Low, priority.Medium, priority.Critical}) {
+		t.Errorf("Union() = %v", got)
+	}
+	if got := set.Intersect(other).Values(); !reflect.DeepEqual(got, []priority.Priority{priority.Low}) {
+		t.Errorf("Intersect() = %v", got)
+	}
+	if got := set.Difference(other).Values(); !reflect.DeepEqual(got, []priority.Priority{priority.Critical}) {
+		t.Errorf("Difference() = %v", got)
+	}
+
+	set.Remove(priority.Low, priority.Priority(0))
+	if got := set.Values(); !reflect.DeepEqual(got, []priority.Priority{priority.Critical}) {
+		t.Errorf("Remove() = %v", got)
+	}
+}
+
+func TestPrioritySetJSON(t *testing.T) {
+	set, _ := priority.NewPrioritySet(priority.High, priority.Low)
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `["low","high"]` {
+		t.Errorf("unexpected json %s", data)
+	}
+
+	var res priority.PrioritySet
+	if err := json.Unmarshal(data, &res); err != nil || res != set {
+		t.Errorf("json.Unmarshal() = %v, %v, want %v", res.Values(), err, set.Values())
+	}
+	if data, _ := json.Marshal(priority.PrioritySet{}); string(data) != "[]" {
+		t.Errorf("unexpected json for an empty set %s", data)
+	}
+	if err := json.Unmarshal([]byte(`["low","urgent"]`), &res); err == nil {
+		t.Error("expected an error for an unknown value")
+	}
+}
+
+func TestPrioritySetBSON(t *testing.T) {
+	set, _ := priority.NewPrioritySet(priority.Medium, priority.Critical)
+
+	data, err := bson.Marshal(bson.M{"set": set})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var res struct{ Set priority.PrioritySet }
+	if err := bson.Unmarshal(data, &res); err != nil || res.Set != set {
+		t.Errorf("bson.Unmarshal() = %v, %v, want %v", res.Set.Values(), err, set.Values())
+	}
+}
+
+func TestPrioritySetSQL(t *testing.T) {
+	set, _ := priority.NewPrioritySet(priority.Critical, priority.Low)
+
+	value, err := set.Value()
+	if err != nil || value != "{1,4}" {
+		t.Errorf("Value() = %v, %v, want {1,4}", value, err)
+	}
+
+	for _, input := range []any{"{1,4}", []byte("1,4"), `{"low","critical"}`} {
+		var res priority.PrioritySet
+		if err := res.Scan(input); err != nil || res != set {
+			t.Errorf("Scan(%v) = %v, %v, want %v", input, res.Values(), err, set.Values())
+		}
+	}
+
+	var res priority.PrioritySet
+	if err := res.Scan(nil); err != nil || res.Len() != 0 {
+		t.Errorf("Scan(nil) = %v, %v, want an empty set", res.Values(), err)
+	}
+	if err := res.Scan("{1,0}"); err == nil {
+		t.Error("expected an error for an invalid value")
+	}
+}
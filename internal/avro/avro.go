@@ -0,0 +1,41 @@
+package avro
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// namePattern matches the names and symbols allowed by the Avro specification.
+var namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Schema is an Avro enum schema.
+type Schema struct {
+	Name    string
+	Symbols []string
+	Default string
+}
+
+// Validate checks the name, symbols and default of the schema against the
+// Avro naming rules.
+func (s Schema) Validate() error {
+	if !namePattern.MatchString(s.Name) {
+		return fmt.Errorf("avro: %s is not a valid name", s.Name)
+	}
+
+	seen := map[string]bool{}
+	for _, symbol := range s.Symbols {
+		if !namePattern.MatchString(symbol) {
+			return fmt.Errorf("avro: %s is not a valid symbol of %s, symbols must match %s", symbol, s.Name, namePattern)
+		}
+		if seen[symbol] {
+			return fmt.Errorf("avro: %s is a duplicate symbol of %s", symbol, s.Name)
+		}
+		seen[symbol] = true
+	}
+
+	if s.Default != "" && !seen[s.Default] {
+		return fmt.Errorf("avro: the default %s is not a symbol of %s", s.Default, s.Name)
+	}
+
+	return nil
+}
@@ -18,17 +18,57 @@ type Config struct {
 	StringerCase string
 
 	Generate struct {
-		Gql        string
-		Bson       bool
-		Json       bool
-		Xml        bool
-		Sql        bool
-		Ent        bool
-		Text       bool
-		NoStringer bool
+		Gql          string
+		GqlContext   bool
+		GqlDirective string
+		GqlGoEnum    bool
+		GqlSchemaDir string
+		GqlGroups    bool
+		Bson         Marshaler
+		BsonCodec    bool
+		Json         Marshaler
+		Xml          Marshaler
+		Yaml         Marshaler
+		Toml         Marshaler
+		Msgpack      Marshaler
+		Cbor         Marshaler
+		Avro         bool
+		Binary       Marshaler
+		Ordinal      bool
+		Set          Marshaler
+		Map          bool
+		Atomic       bool
+		Register     bool
+		Open         bool
+		Predicates   bool
+		Diagram      string
+		Sql          Marshaler
+		SqlDdl       string
+		Ent          Marshaler
+		Pgx          bool
+		Gorm         bool
+		Sqlc         bool
+		Text         bool
+		Flag         Marshaler
+		Decode       bool
+		NoStringer   bool
+		Test         bool
 	}
 }
 
+// UsesValue reports whether any of the marshalers stores the underlying value
+// of the enum rather than its string representation.
+func (c *Config) UsesValue() bool {
+	return c.Generate.Bson.UseValue() ||
+		c.Generate.Json.UseValue() ||
+		c.Generate.Xml.UseValue() ||
+		c.Generate.Yaml.UseValue() ||
+		c.Generate.Toml.UseValue() ||
+		c.Generate.Msgpack.UseValue() ||
+		c.Generate.Cbor.UseValue() ||
+		c.Generate.Sql.UseValue()
+}
+
 var config *Config
 
 func init() {
@@ -44,6 +84,7 @@ func loadConfig() *Config {
 		Verbose:      false,
 		StringerCase: "snake",
 	}
+	config.Generate.GqlDirective = "gomodel"
 
 	config.FileName = strings.TrimSuffix(os.Getenv("GOFILE"), ".go")
 	config.EnumName = coerce.PascalCase(config.FileName)
@@ -51,6 +92,35 @@ func loadConfig() *Config {
 
 	overrideWithFlags(config)
 
+	// Ent stores the enum through the sql marshalers, so they have to use the
+	// same representation.
+	if config.Generate.Ent.Enabled {
+		if config.Generate.Sql.Enabled && config.Generate.Sql.UseValue() != config.Generate.Ent.UseValue() {
+			panic("-ent and -sql have to use the same representation")
+		}
+
+		config.Generate.Sql.Enabled = true
+		if config.Generate.Ent.UseValue() {
+			config.Generate.Sql.Options = append(config.Generate.Sql.Options, "value")
+		}
+	}
+
+	// Configuration libraries decoding yaml files use the yaml marshalers.
+	if config.Generate.Decode {
+		config.Generate.Yaml.Enabled = true
+	}
+
+	// Sets and maps are indexed by the ordinals of the values, and the atomic
+	// of non integer types stores the ordinal of the value.
+	if config.Generate.Set.Enabled || config.Generate.Map || config.Generate.Atomic {
+		config.Generate.Ordinal = true
+	}
+
+	// The context aware marshaler replaces the plain gqlgen marshaler.
+	if config.Generate.GqlContext && config.Generate.Gql == "" {
+		config.Generate.Gql = "go"
+	}
+
 	return config
 }
 
@@ -69,12 +139,39 @@ func overrideWithFlags(config *Config) {
 	})
 
 	bindString("gql", &config.Generate.Gql, "'go': only generate marshaller, 'gql' only generate gql enum, 'full' generate both the marshaller and enum")
-	bindBool("bson", &config.Generate.Bson, "generate functions for Bson")
-	bindBool("json", &config.Generate.Json, "generate functions for Json")
-	bindBool("xml", &config.Generate.Xml, "generate functions for Xml")
-	bindBool("sql", &config.Generate.Sql, "generate functions for sql")
-	bindBool("ent", &config.Generate.Ent, "generate functions for ent")
+	bindBool("gql-context", &config.Generate.GqlContext, "generate the context aware gqlgen marshaler, returning errors with the field path instead of writing invalid values, will also enable -gql=go")
+	bindString("gql-directive", &config.Generate.GqlDirective, "the directive binding the graphql enum to the go model, 'gomodel', 'none' or a custom directive in which {model} is replaced by the model, for example '@bind(type: \"{model}\")'")
+	bindBool("gql-goenum", &config.Generate.GqlGoEnum, "add a gqlgen @goEnum directive to each graphql enum value, binding it to its constant")
+	bindString("gql-schema-dir", &config.Generate.GqlSchemaDir, "write the graphql enum to <package>_<enum>.graphql in this directory, instead of next to the source")
+	bindBool("gql-groups", &config.Generate.GqlGroups, "add a graphql enum for each //enum:group=name, named <Enum><Group>, for fields accepting a subset")
+	bindMarshaler("bson", &config.Generate.Bson, "generate functions for Bson, 'name' or 'value' selects the stored representation, 'mgo', 'mongo', 'mongo-v2' or 'both' selects the driver", representation, []string{"mgo", "mongo", "mongo-v2", "both"})
+	bindBool("bson-codec", &config.Generate.BsonCodec, "generate a mongo-driver codec, uses the driver and representation of -bson")
+	bindMarshaler("json", &config.Generate.Json, "generate functions for Json, 'name' or 'value' selects the stored representation", representation)
+	bindMarshaler("xml", &config.Generate.Xml, "generate functions for Xml, 'name' or 'value' selects the stored representation", representation)
+	bindMarshaler("yaml", &config.Generate.Yaml, "generate functions for yaml.v3, 'name' or 'value' selects the stored representation", representation)
+	bindMarshaler("toml", &config.Generate.Toml, "generate functions for toml, will also enable -text, 'name' or 'value' selects the stored representation", representation)
+	bindMarshaler("msgpack", &config.Generate.Msgpack, "generate functions for msgpack, 'name' or 'value' selects the stored representation", representation)
+	bindMarshaler("cbor", &config.Generate.Cbor, "generate functions for cbor, 'name' or 'value' selects the stored representation", representation)
+	bindMarshaler("binary", &config.Generate.Binary, "generate functions for encoding.BinaryMarshaler and gob, 'name' stores the string representation, 'value' a varint of the underlying value, 'id' a varint of the //enum:id=N of each value", []string{"name", "value", "id"})
+	bindBool("avro", &config.Generate.Avro, "generate an avro enum schema")
+	bindMarshaler("sql", &config.Generate.Sql, "generate functions for sql, 'name' or 'value' selects the stored representation", representation)
+	bindString("sql-ddl", &config.Generate.SqlDdl, "'postgres' generate a native enum type and migrations, 'mysql' generate an enum column definition")
+	bindMarshaler("ent", &config.Generate.Ent, "generate functions for ent, will also enable -sql, 'field' generates a schema field, 'gql' adds entgql annotations to it, 'value' stores the underlying value", representation, []string{"field"}, []string{"gql"})
+	bindBool("pgx", &config.Generate.Pgx, "generate functions for pgx, uses the representation of -sql")
+	bindBool("gorm", &config.Generate.Gorm, "generate functions for gorm, will also enable -sql")
+	bindBool("sqlc", &config.Generate.Sqlc, "generate sqlc overrides for the type generated by -sql-ddl=postgres")
 	bindBool("text", &config.Generate.Text, "generate functions for text")
+	bindMarshaler("flag", &config.Generate.Flag, "generate functions for flag, 'pflag' adds a pflag helper, 'cobra' adds completion functions", []string{"pflag"}, []string{"cobra"})
+	bindBool("decode", &config.Generate.Decode, "generate an envconfig decoder and a mapstructure decode hook, will also enable -yaml")
+	bindBool("ordinal", &config.Generate.Ordinal, "generate ordinal functions over the valid values, in the order of declaration or of //enum:order=N")
+	bindBool("register", &config.Generate.Register, "register the enum and its values in the runtime registry of the enum package")
+	bindMarshaler("set", &config.Generate.Set, "generate an <Enum>Set bitset over the ordinals, will also enable -ordinal, 'comma' or 'array' selects how -sql stores the set", []string{"comma", "array"})
+	bindBool("map", &config.Generate.Map, "generate a generic <Enum>Map[T] backed by an array indexed by the ordinals, will also enable -ordinal")
+	bindBool("atomic", &config.Generate.Atomic, "generate an Atomic<Enum> for concurrent access, stores the ordinal for non integer types, will also enable -ordinal")
+	bindBool("predicates", &config.Generate.Predicates, "generate an Is method for every value, and IsValid, IsDefault and IsZero")
+	bindBool("open", &config.Generate.Open, "generate an Open<Enum> wrapper preserving unknown strings in the text, json, xml, yaml and bson marshalers")
+	bindString("diagram", &config.Generate.Diagram, "'mermaid' or 'dot' generate a diagram of the //enum:to transitions")
+	bindBool("test", &config.Generate.Test, "generate tests for the generated marshalers")
 	bindBool("no-stringer", &config.Generate.NoStringer, "disable generation of the stringer function")
 	flag.Parse()
 }
@@ -83,6 +180,15 @@ func bindString(name string, dest *string, usage string) {
 	flag.CommandLine.StringVar(dest, name, *dest, usage)
 }
 
+var representation = []string{"name", "value"}
+
+// bindMarshaler binds a marshaler flag, only a single option of each of the
+// exclusive groups can be selected.
+func bindMarshaler(name string, dest *Marshaler, usage string, exclusive ...[]string) {
+	dest.exclusive = exclusive
+	flag.CommandLine.Var(dest, name, usage)
+}
+
 func bindBool(name string, dest *bool, usage string) {
 	flag.CommandLine.BoolVar(dest, name, *dest, usage)
 }
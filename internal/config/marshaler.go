@@ -0,0 +1,80 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/klippa-app/go-enum/internal/util"
+)
+
+// Marshaler is a flag that can either be toggled on its own (-json) or be
+// given a comma separated list of options (-json=value).
+type Marshaler struct {
+	Enabled bool
+	Options []string
+
+	exclusive [][]string
+}
+
+func (m *Marshaler) String() string {
+	if m == nil || !m.Enabled {
+		return "false"
+	}
+	if len(m.Options) == 0 {
+		return "true"
+	}
+	return strings.Join(m.Options, ",")
+}
+
+func (m *Marshaler) Set(s string) error {
+	if enabled, err := strconv.ParseBool(s); err == nil {
+		m.Enabled = enabled
+		m.Options = nil
+		return nil
+	}
+
+	var allowed []string
+	for i := range m.exclusive {
+		allowed = append(allowed, m.exclusive[i]...)
+	}
+
+	options := strings.Split(strings.ReplaceAll(s, " ", ""), ",")
+	for i := range options {
+		if !util.Contains(allowed, options[i]) {
+			return fmt.Errorf("unknown option '%s', expected one of: %s", options[i], strings.Join(allowed, ", "))
+		}
+	}
+
+	// Only a single option of each group can be selected.
+	for i := range m.exclusive {
+		var selected []string
+		for j := range options {
+			if util.Contains(m.exclusive[i], options[j]) {
+				selected = append(selected, options[j])
+			}
+		}
+
+		if len(selected) > 1 {
+			return fmt.Errorf("options %s can not be combined", strings.Join(selected, ", "))
+		}
+	}
+
+	m.Enabled = true
+	m.Options = options
+	return nil
+}
+
+func (m *Marshaler) IsBoolFlag() bool {
+	return true
+}
+
+func (m Marshaler) Has(option string) bool {
+	return util.Contains(m.Options, option)
+}
+
+// UseValue reports whether the marshaler should use the underlying value of
+// the enum rather than its string representation.
+func (m Marshaler) UseValue() bool {
+	return m.Enabled && m.Has("value")
+}
@@ -0,0 +1,105 @@
+package ddl
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/klippa-app/go-enum/internal/util"
+)
+
+var quoted = regexp.MustCompile(`'((?:[^']|'')*)'`)
+
+type Addition struct {
+	Value string
+	// Either "AFTER" or "BEFORE" the neighbour, empty when none of the other
+	// values existed before.
+	Position  string
+	Neighbour string
+}
+
+type Migration struct {
+	Added   []Addition
+	Removed []string
+}
+
+func (m Migration) IsEmpty() bool {
+	return len(m.Added) == 0 && len(m.Removed) == 0
+}
+
+func Quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// QuoteIdent quotes an identifier, so names like order can be used as the
+// name of a type.
+func QuoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// ReadValues reads the values of a previously generated DDL file, returns nil
+// when the file does not exist yet.
+func ReadValues(path string) []string {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	var values []string
+	for _, match := range quoted.FindAllStringSubmatch(string(data), -1) {
+		values = append(values, strings.ReplaceAll(match[1], "''", "'"))
+	}
+	return values
+}
+
+// Diff returns the values that have to be added to the previous values to get
+// the current values, positioned relative to the values that precede them.
+func Diff(previous []string, current []string) (migration Migration) {
+	if previous == nil {
+		return migration
+	}
+
+	known := map[string]bool{}
+	for i := range previous {
+		known[previous[i]] = true
+	}
+
+	for i := range current {
+		if known[current[i]] {
+			continue
+		}
+
+		addition := Addition{Value: current[i]}
+		if i > 0 {
+			// The preceding value is either known, or added just before.
+			addition.Position, addition.Neighbour = "AFTER", current[i-1]
+		} else {
+			for j := range current {
+				if util.Contains(previous, current[j]) {
+					addition.Position, addition.Neighbour = "BEFORE", current[j]
+					break
+				}
+			}
+		}
+
+		migration.Added = append(migration.Added, addition)
+		known[current[i]] = true
+	}
+
+	for i := range previous {
+		if !util.Contains(current, previous[i]) {
+			migration.Removed = append(migration.Removed, previous[i])
+		}
+	}
+
+	return migration
+}
+
+type Definition struct {
+	Name      string
+	Values    []string
+	Migration Migration
+}
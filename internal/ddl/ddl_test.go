@@ -0,0 +1,134 @@
+package ddl
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []string
+		current  []string
+		want     Migration
+	}{
+		{
+			name:    "no previous file",
+			current: []string{"a", "b"},
+		},
+		{
+			name:     "unchanged",
+			previous: []string{"a", "b"},
+			current:  []string{"a", "b"},
+		},
+		{
+			name:     "added at the end",
+			previous: []string{"a", "b"},
+			current:  []string{"a", "b", "c"},
+			want:     Migration{Added: []Addition{{Value: "c", Position: "AFTER", Neighbour: "b"}}},
+		},
+		{
+			name:     "added at the start",
+			previous: []string{"b", "c"},
+			current:  []string{"a", "b", "c"},
+			want:     Migration{Added: []Addition{{Value: "a", Position: "BEFORE", Neighbour: "b"}}},
+		},
+		{
+			name:     "added after each other",
+			previous: []string{"a"},
+			current:  []string{"a", "b", "c"},
+			want: Migration{Added: []Addition{
+				{Value: "b", Position: "AFTER", Neighbour: "a"},
+				{Value: "c", Position: "AFTER", Neighbour: "b"},
+			}},
+		},
+		{
+			name:     "all values replaced",
+			previous: []string{"a"},
+			current:  []string{"b"},
+			want: Migration{
+				Added:   []Addition{{Value: "b"}},
+				Removed: []string{"a"},
+			},
+		},
+		{
+			name:     "removed",
+			previous: []string{"a", "b", "c"},
+			current:  []string{"a", "c"},
+			want:     Migration{Removed: []string{"b"}},
+		},
+		{
+			// Enum values can not be reordered, the order of the previous
+			// file is kept by the database.
+			name:     "reordered",
+			previous: []string{"a", "b", "c"},
+			current:  []string{"c", "a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			migration := Diff(test.previous, test.current)
+			if !reflect.DeepEqual(migration, test.want) {
+				t.Errorf("Diff() = %+v, want %+v", migration, test.want)
+			}
+			if migration.IsEmpty() != (len(test.want.Added) == 0 && len(test.want.Removed) == 0) {
+				t.Errorf("IsEmpty() = %v for %+v", migration.IsEmpty(), migration)
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := map[string]string{
+		"value":         "'value'",
+		"it's":          "'it''s'",
+		"":              "''",
+		`say "hi"`:      `'say "hi"'`,
+		"two '' quotes": "'two '''' quotes'",
+	}
+	for value, want := range tests {
+		if quoted := Quote(value); quoted != want {
+			t.Errorf("Quote(%s) = %s, want %s", value, quoted, want)
+		}
+	}
+}
+
+func TestQuoteIdent(t *testing.T) {
+	tests := map[string]string{
+		"order":      `"order"`,
+		"MixedCase":  `"MixedCase"`,
+		`weird"name`: `"weird""name"`,
+	}
+	for name, want := range tests {
+		if quoted := QuoteIdent(name); quoted != want {
+			t.Errorf("QuoteIdent(%s) = %s, want %s", name, quoted, want)
+		}
+	}
+}
+
+func TestReadValues(t *testing.T) {
+	dir := t.TempDir()
+
+	if values := ReadValues(filepath.Join(dir, "missing.sql")); values != nil {
+		t.Errorf("expected no values for a missing file, got %q", values)
+	}
+
+	tests := map[string]string{
+		"mysql.sql":    "-- Code generated by go-enum, DO NOT EDIT.\nENUM('a', 'it''s', 'c')",
+		"postgres.sql": "-- Code generated by go-enum, DO NOT EDIT.\nCREATE TYPE \"order\" AS ENUM (\n\t'a',\n\t'it''s',\n\t'c'\n);",
+	}
+	for name, ddl := range tests {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(ddl), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		want := []string{"a", "it's", "c"}
+		if values := ReadValues(path); !reflect.DeepEqual(values, want) {
+			t.Errorf("ReadValues(%s) = %q, want %q", name, values, want)
+		}
+	}
+}
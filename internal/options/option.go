@@ -9,6 +9,10 @@ type Option string
 const (
 	DefaultOption Option = "default"
 	InvalidOption Option = "invalid"
+	IdOption      Option = "id"
+	OrderOption   Option = "order"
+	GroupOption   Option = "group"
+	ToOption      Option = "to"
 )
 
 var validOptions = []Option{
@@ -16,6 +20,28 @@ var validOptions = []Option{
 	InvalidOption,
 }
 
+// validValueOptions take a value, for example "id=1".
+var validValueOptions = []Option{
+	IdOption,
+	OrderOption,
+	GroupOption,
+	ToOption,
+}
+
+// validListOptions take a list of values, for example "group=weekend,rest".
+var validListOptions = []Option{
+	GroupOption,
+	ToOption,
+}
+
 func (o Option) isValid() bool {
 	return util.Contains(validOptions, o)
 }
+
+func (o Option) isValidWithValue() bool {
+	return util.Contains(validValueOptions, o)
+}
+
+func (o Option) isList() bool {
+	return util.Contains(validListOptions, o)
+}
@@ -28,10 +28,23 @@ func Parse(name string, cgroup *ast.CommentGroup, enumDefault *string) []string
 	options := strings.Split(strings.ReplaceAll(cmd, " ", ""), ",")
 	// [default, invalid]
 
+	// List options continue with the values that are not an option, so
+	// "group=weekend,rest" becomes [group=weekend, group=rest].
+	var list Option
 	for i := range options {
-		option := Option(options[i])
-		if !option.isValid() {
-			panic(fmt.Sprintf("unknown option: '%s'\n", option))
+		key, _, hasValue := strings.Cut(options[i], "=")
+		option := Option(key)
+		if !hasValue && !option.isValid() && list != "" {
+			options[i] = fmt.Sprint(list, "=", options[i])
+			continue
+		}
+		if hasValue && !option.isValidWithValue() || !hasValue && !option.isValid() {
+			panic(fmt.Sprintf("unknown option: '%s'\n", options[i]))
+		}
+
+		list = ""
+		if hasValue && option.isList() {
+			list = option
 		}
 
 		if name != "" && Option(option) == DefaultOption {
@@ -44,3 +57,26 @@ func Parse(name string, cgroup *ast.CommentGroup, enumDefault *string) []string
 
 	return options
 }
+
+// Value returns the value of an option taking a value, for example "1" for
+// "id=1".
+func Value(options []string, option Option) (string, bool) {
+	for i := range options {
+		if key, value, ok := strings.Cut(options[i], "="); ok && Option(key) == option {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Values returns the values of a list option, for example [weekend, rest] for
+// "group=weekend,rest".
+func Values(options []string, option Option) []string {
+	var values []string
+	for i := range options {
+		if key, value, ok := strings.Cut(options[i], "="); ok && Option(key) == option {
+			values = append(values, value)
+		}
+	}
+	return values
+}
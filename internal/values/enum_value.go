@@ -1,18 +1,25 @@
 package values
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/klippa-app/go-enum/internal/options"
 	"github.com/klippa-app/go-enum/internal/util"
 )
 
 type EnumValue struct {
-	Name    string
-	Type    string
-	Options []string
-	Value   string
+	Name        string
+	Type        string
+	Options     []string
+	Value       string
+	Pos         token.Pos
+	Description string
 }
 
 func ExtractEnumValues(typeInfo *types.Info, enumType string) (enums []EnumValue, underlyingType string, enumDefault string) {
@@ -39,13 +46,104 @@ func ExtractEnumValues(typeInfo *types.Info, enumType string) (enums []EnumValue
 				}
 
 				enums = append(enums, EnumValue{
-					Name:    object.Name(),
-					Type:    underlyingType,
-					Options: options.Parse(object.Name(), value.Comment, &enumDefault),
-					Value:   object.Val().ExactString(),
+					Name:        object.Name(),
+					Type:        underlyingType,
+					Options:     options.Parse(object.Name(), value.Comment, &enumDefault),
+					Value:       object.Val().ExactString(),
+					Pos:         object.Pos(),
+					Description: description(value.Doc, genDecls[i]),
 				})
 			}
 		}
 	}
+
+	// The scopes are a map, sort by the source position to generate the
+	// values in the order of declaration.
+	sort.SliceStable(enums, func(i, j int) bool {
+		return enums[i].Pos < enums[j].Pos
+	})
+	sortByOrder(enums)
+
 	return
 }
+
+// description returns the doc comment of a value, falling back to the doc
+// comment of the declaration when it declares a single value.
+func description(doc *ast.CommentGroup, decl *ast.GenDecl) string {
+	if doc == nil && len(decl.Specs) == 1 {
+		doc = decl.Doc
+	}
+
+	return strings.TrimSpace(doc.Text())
+}
+
+// sortByOrder sorts the values by their //enum:order=N option, when it is
+// used every valid value needs a unique order. Invalid values without an order
+// are sorted first.
+func sortByOrder(enums []EnumValue) {
+	orders := make([]int, len(enums))
+	seen := map[int]string{}
+	var ordered, unordered []string
+	for i := range enums {
+		value, ok := options.Value(enums[i].Options, options.OrderOption)
+		if !ok {
+			orders[i] = -1
+			if !util.Contains(enums[i].Options, string(options.InvalidOption)) {
+				unordered = append(unordered, enums[i].Name)
+			}
+			continue
+		}
+
+		order, err := strconv.Atoi(value)
+		if err != nil || order < 0 {
+			panic(fmt.Sprintf("%s has an invalid order: %s", enums[i].Name, value))
+		}
+		if other, ok := seen[order]; ok {
+			panic(fmt.Sprintf("%s and %s have the same order: %d", other, enums[i].Name, order))
+		}
+		seen[order] = enums[i].Name
+		orders[i] = order
+		ordered = append(ordered, enums[i].Name)
+	}
+
+	if len(ordered) == 0 {
+		return
+	}
+	if len(unordered) > 0 {
+		panic(fmt.Sprintf("%s have no //enum:order=N, while %s do", unordered, ordered))
+	}
+
+	sort.Stable(byOrder{enums: enums, orders: orders})
+}
+
+type byOrder struct {
+	enums  []EnumValue
+	orders []int
+}
+
+func (b byOrder) Len() int { return len(b.enums) }
+
+func (b byOrder) Less(i, j int) bool { return b.orders[i] < b.orders[j] }
+
+func (b byOrder) Swap(i, j int) {
+	b.enums[i], b.enums[j] = b.enums[j], b.enums[i]
+	b.orders[i], b.orders[j] = b.orders[j], b.orders[i]
+}
+
+// OptionValue returns the value of an option taking a value, or an empty
+// string when the option is not set.
+func (e EnumValue) OptionValue(option string) string {
+	value, _ := options.Value(e.Options, options.Option(option))
+	return value
+}
+
+// Valid returns the values without the //enum:invalid option.
+func Valid(enums []EnumValue) []EnumValue {
+	var valid []EnumValue
+	for i := range enums {
+		if !util.Contains(enums[i].Options, string(options.InvalidOption)) {
+			valid = append(valid, enums[i])
+		}
+	}
+	return valid
+}
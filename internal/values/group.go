@@ -0,0 +1,64 @@
+package values
+
+import (
+	"fmt"
+	"go/token"
+
+	"github.com/klippa-app/go-enum/coerce"
+	"github.com/klippa-app/go-enum/internal/options"
+	"github.com/klippa-app/go-enum/internal/util"
+)
+
+// reservedGroups are the group names whose Is<Group> method or
+// <Group><Plural> function is already generated, for example IsValid by
+// -predicates or AllDays by every enum.
+var reservedGroups = []string{"All", "Valid", "Default", "Zero", "Terminal"}
+
+// Group is a named subset of the enum values, declared with the
+// //enum:group=name option.
+type Group struct {
+	Name string
+	// Values are the names of the constants in the group.
+	Values []string
+}
+
+// Groups returns the groups in the order they are first used.
+func Groups(enums []EnumValue) []Group {
+	var groups []Group
+	index := map[string]int{}
+	for i := range enums {
+		for _, name := range options.Values(enums[i].Options, options.GroupOption) {
+			j, ok := index[name]
+			if !ok {
+				j = len(groups)
+				index[name] = j
+				groups = append(groups, Group{Name: name})
+			}
+
+			if !util.Contains(groups[j].Values, enums[i].Name) {
+				groups[j].Values = append(groups[j].Values, enums[i].Name)
+			}
+		}
+	}
+	return groups
+}
+
+// ValidateGroups returns an error when the Is<Group> method or <Group><Plural>
+// function of a group clashes with the generated code or another group.
+func ValidateGroups(groups []Group) error {
+	names := map[string]string{}
+	for i := range groups {
+		name := coerce.PascalCase(groups[i].Name)
+		if !token.IsIdentifier(name) {
+			return fmt.Errorf("the group %s is not a valid identifier", groups[i].Name)
+		}
+		if util.Contains(reservedGroups, name) {
+			return fmt.Errorf("the group %s clashes with the generated Is%s method or %s<Plural> function", groups[i].Name, name, name)
+		}
+		if other, ok := names[name]; ok {
+			return fmt.Errorf("the groups %s and %s both generate Is%s", other, groups[i].Name, name)
+		}
+		names[name] = groups[i].Name
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package values
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroups(t *testing.T) {
+	enums := []EnumValue{
+		{Name: "Monday", Options: []string{"group=weekday"}},
+		{Name: "Saturday", Options: []string{"group=weekend", "group=rest"}},
+		{Name: "Sunday", Options: []string{"group=weekend", "group=rest"}},
+	}
+
+	want := []Group{
+		{Name: "weekday", Values: []string{"Monday"}},
+		{Name: "weekend", Values: []string{"Saturday", "Sunday"}},
+		{Name: "rest", Values: []string{"Saturday", "Sunday"}},
+	}
+	groups := Groups(enums)
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Groups() = %+v, want %+v", groups, want)
+	}
+	if err := ValidateGroups(groups); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateGroups(t *testing.T) {
+	tests := map[string][]Group{
+		"all":        {{Name: "all"}},
+		"valid":      {{Name: "valid"}},
+		"default":    {{Name: "default"}},
+		"zero":       {{Name: "zero"}},
+		"terminal":   {{Name: "terminal"}},
+		"duplicate":  {{Name: "week_end"}, {Name: "weekEnd"}},
+		"identifier": {{Name: "1st"}},
+	}
+
+	for name, groups := range tests {
+		if err := ValidateGroups(groups); err == nil {
+			t.Errorf("%s: expected an error for %+v", name, groups)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package values
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// BaseKind groups the primitive underlying types of an enum into the kinds
+// that share the same conversion and parsing code.
+func BaseKind(baseType string) string {
+	switch {
+	case baseType == "string":
+		return "string"
+	case baseType == "bool":
+		return "bool"
+	case strings.HasPrefix(baseType, "float"):
+		return "float"
+	case strings.HasPrefix(baseType, "uint"):
+		return "uint"
+	case strings.HasPrefix(baseType, "int"):
+		return "int"
+	}
+
+	panic(fmt.Sprintf("unsupported underlying type: %s", baseType))
+}
+
+// DriverType returns the type the database/sql driver uses to represent the
+// underlying type.
+func DriverType(baseType string) string {
+	switch BaseKind(baseType) {
+	case "int", "uint":
+		return "int64"
+	case "float":
+		return "float64"
+	}
+	return baseType
+}
+
+// Parser returns the strconv call that parses str into the underlying type.
+func Parser(baseType string, str string) string {
+	bits := strings.TrimLeftFunc(baseType, unicode.IsLetter)
+	if bits == "" {
+		bits = "0"
+	}
+
+	switch BaseKind(baseType) {
+	case "int":
+		return fmt.Sprintf("strconv.ParseInt(%s, 10, %s)", str, bits)
+	case "uint":
+		return fmt.Sprintf("strconv.ParseUint(%s, 10, %s)", str, bits)
+	case "float":
+		return fmt.Sprintf("strconv.ParseFloat(%s, %s)", str, bits)
+	case "bool":
+		return fmt.Sprintf("strconv.ParseBool(%s)", str)
+	}
+
+	panic(fmt.Sprintf("no parser for underlying type: %s", baseType))
+}
+
+// Zero returns the zero value of the underlying type.
+func Zero(baseType string) string {
+	switch BaseKind(baseType) {
+	case "string":
+		return `""`
+	case "bool":
+		return "false"
+	}
+	return "0"
+}
+
+// EntField returns the ent schema/field constructor that stores the
+// underlying type.
+func EntField(baseType string) string {
+	switch baseType {
+	case "string":
+		return "field.String"
+	case "bool":
+		return "field.Bool"
+	case "float64":
+		return "field.Float"
+	case "float32":
+		return "field.Float32"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return "field." + strings.ToUpper(baseType[:1]) + baseType[1:]
+	}
+
+	panic(fmt.Sprintf("ent: unsupported underlying type: %s", baseType))
+}
@@ -0,0 +1,101 @@
+package values
+
+import (
+	"fmt"
+
+	"github.com/klippa-app/go-enum/internal/options"
+	"github.com/klippa-app/go-enum/internal/util"
+)
+
+// Machine is the state machine declared with the //enum:to=Next option.
+type Machine struct {
+	// Initial is the default value when it is valid, or the first valid value.
+	Initial string
+	States  []State
+}
+
+// State is a valid value with the names of the constants it can transition to.
+type State struct {
+	Name       string
+	Successors []string
+}
+
+// Transitions returns the state machine of the valid values, or an empty
+// machine when no value declares a transition. It panics when a transition
+// involves an invalid or undeclared value, or a state can not be reached.
+func Transitions(enums []EnumValue, enumDefault string) Machine {
+	var machine Machine
+	declared := map[string]bool{}
+	invalid := map[string]bool{}
+	for i := range enums {
+		declared[enums[i].Name] = true
+		invalid[enums[i].Name] = util.Contains(enums[i].Options, string(options.InvalidOption))
+	}
+
+	used := false
+	for i := range enums {
+		successors := options.Values(enums[i].Options, options.ToOption)
+		used = used || len(successors) > 0
+
+		if invalid[enums[i].Name] {
+			if len(successors) > 0 {
+				panic(fmt.Sprintf("%s is invalid and can not have transitions", enums[i].Name))
+			}
+			continue
+		}
+
+		for _, successor := range successors {
+			if !declared[successor] {
+				panic(fmt.Sprintf("%s transitions to %s, which is not a value of the enum", enums[i].Name, successor))
+			}
+			if invalid[successor] {
+				panic(fmt.Sprintf("%s transitions to %s, which is invalid", enums[i].Name, successor))
+			}
+		}
+
+		if machine.Initial == "" || enums[i].Name == enumDefault {
+			machine.Initial = enums[i].Name
+		}
+		machine.States = append(machine.States, State{Name: enums[i].Name, Successors: successors})
+	}
+
+	if !used {
+		return Machine{}
+	}
+
+	if unreachable := machine.Unreachable(); len(unreachable) > 0 {
+		panic(fmt.Sprintf("%v can not be reached from the initial state %s", unreachable, machine.Initial))
+	}
+
+	return machine
+}
+
+// Unreachable returns the states that can not be reached from the initial
+// state.
+func (m Machine) Unreachable() []string {
+	successors := map[string][]string{}
+	for i := range m.States {
+		successors[m.States[i].Name] = m.States[i].Successors
+	}
+
+	reached := map[string]bool{m.Initial: true}
+	queue := []string{m.Initial}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for _, successor := range successors[state] {
+			if !reached[successor] {
+				reached[successor] = true
+				queue = append(queue, successor)
+			}
+		}
+	}
+
+	var unreachable []string
+	for i := range m.States {
+		if !reached[m.States[i].Name] {
+			unreachable = append(unreachable, m.States[i].Name)
+		}
+	}
+	return unreachable
+}
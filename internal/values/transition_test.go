@@ -0,0 +1,117 @@
+package values
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransitions(t *testing.T) {
+	enums := []EnumValue{
+		{Name: "Unknown", Options: []string{"invalid"}},
+		{Name: "Created", Options: []string{"to=Paid", "to=Cancelled"}},
+		{Name: "Paid"},
+		{Name: "Cancelled"},
+	}
+
+	want := Machine{
+		Initial: "Created",
+		States: []State{
+			{Name: "Created", Successors: []string{"Paid", "Cancelled"}},
+			{Name: "Paid"},
+			{Name: "Cancelled"},
+		},
+	}
+	if machine := Transitions(enums, ""); !reflect.DeepEqual(machine, want) {
+		t.Errorf("Transitions() = %+v, want %+v", machine, want)
+	}
+
+	if machine := Transitions(enums[2:], ""); len(machine.States) != 0 {
+		t.Errorf("expected no machine without transitions, got %+v", machine)
+	}
+}
+
+func TestTransitionsDefault(t *testing.T) {
+	enums := []EnumValue{
+		{Name: "Paid"},
+		{Name: "Created", Options: []string{"to=Paid"}},
+	}
+
+	if machine := Transitions(enums, "Created"); machine.Initial != "Created" {
+		t.Errorf("initial = %s, want Created", machine.Initial)
+	}
+}
+
+func TestTransitionsPanics(t *testing.T) {
+	tests := []struct {
+		name  string
+		enums []EnumValue
+		want  string
+	}{
+		{
+			name: "undeclared successor",
+			enums: []EnumValue{
+				{Name: "Created", Options: []string{"to=Paid"}},
+			},
+			want: "Created transitions to Paid, which is not a value of the enum",
+		},
+		{
+			name: "invalid successor",
+			enums: []EnumValue{
+				{Name: "Unknown", Options: []string{"invalid"}},
+				{Name: "Created", Options: []string{"to=Unknown"}},
+			},
+			want: "Created transitions to Unknown, which is invalid",
+		},
+		{
+			name: "invalid state",
+			enums: []EnumValue{
+				{Name: "Unknown", Options: []string{"invalid", "to=Created"}},
+				{Name: "Created"},
+			},
+			want: "Unknown is invalid and can not have transitions",
+		},
+		{
+			name: "unreachable state",
+			enums: []EnumValue{
+				{Name: "Created", Options: []string{"to=Paid"}},
+				{Name: "Paid"},
+				{Name: "Cancelled", Options: []string{"to=Paid"}},
+			},
+			want: "[Cancelled] can not be reached from the initial state Created",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("panic = %v, want %s", r, test.want)
+				}
+			}()
+
+			Transitions(test.enums, "")
+		})
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	machine := Machine{
+		Initial: "Created",
+		States: []State{
+			{Name: "Created", Successors: []string{"Paid"}},
+			{Name: "Paid", Successors: []string{"Created"}},
+			{Name: "Refunded", Successors: []string{"Closed"}},
+			{Name: "Closed"},
+		},
+	}
+
+	want := []string{"Refunded", "Closed"}
+	if unreachable := machine.Unreachable(); !reflect.DeepEqual(unreachable, want) {
+		t.Errorf("Unreachable() = %v, want %v", unreachable, want)
+	}
+
+	machine.States[1].Successors = append(machine.States[1].Successors, "Refunded")
+	if unreachable := machine.Unreachable(); len(unreachable) != 0 {
+		t.Errorf("Unreachable() = %v, want none", unreachable)
+	}
+}
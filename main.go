@@ -3,11 +3,14 @@ package main
 import (
 	"embed"
 	"fmt"
+	"go/ast"
 	"go/token"
 	"log"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -15,7 +18,10 @@ import (
 	"golang.org/x/tools/go/packages"
 
 	"github.com/klippa-app/go-enum/coerce"
+	"github.com/klippa-app/go-enum/internal/avro"
 	"github.com/klippa-app/go-enum/internal/config"
+	"github.com/klippa-app/go-enum/internal/ddl"
+	"github.com/klippa-app/go-enum/internal/options"
 	"github.com/klippa-app/go-enum/internal/util"
 	"github.com/klippa-app/go-enum/internal/values"
 )
@@ -73,6 +79,8 @@ func main() {
 		BaseType:         underlyingType,
 		EnumValues:       enumValues,
 		EnumDefaultValue: enumDefault,
+		Groups:           values.Groups(enumValues),
+		Machine:          values.Transitions(enumValues, enumDefault),
 		Config:           cfg,
 	}
 
@@ -80,33 +88,199 @@ func main() {
 		ExecuteTemplate(templates, name, fullPath(dir, cfg.FileName, cfg.EnumName, extension), data)
 	}
 
+	if err := values.ValidateGroups(data.Groups); err != nil {
+		panic(err)
+	}
+	if cfg.Generate.Predicates {
+		validatePredicates(cfg, data)
+	}
+
 	execTemplate("enum.tmpl", ".go")
-	if cfg.Generate.Bson {
+	if len(data.Groups) > 0 {
+		execTemplate("group.tmpl", "group.go")
+	}
+	if len(data.Machine.States) > 0 {
+		execTemplate("transition.tmpl", "transition.go")
+		switch cfg.Generate.Diagram {
+		case "":
+		case "mermaid":
+			execTemplate("transition.mermaid.tmpl", ".mmd")
+		case "dot":
+			execTemplate("transition.dot.tmpl", ".dot")
+		default:
+			panic(fmt.Sprintf("unknown diagram: %s", cfg.Generate.Diagram))
+		}
+	}
+	if cfg.Generate.Open {
+		validateOpen(cfg)
+		execTemplate("open.tmpl", "open.go")
+	}
+	if cfg.Generate.Register {
+		execTemplate("register.tmpl", "register.go")
+	}
+	if cfg.Generate.Ordinal {
+		execTemplate("ordinal.tmpl", "ordinal.go")
+	}
+	if cfg.Generate.Set.Enabled {
+		execTemplate("set.tmpl", "set.go")
+	}
+	if cfg.Generate.Map {
+		execTemplate("map.tmpl", "map.go")
+	}
+	if cfg.Generate.Atomic {
+		execTemplate("atomic.tmpl", "atomic.go")
+		if cfg.Generate.Test {
+			execTemplate("atomic.test.tmpl", "atomic_test.go")
+		}
+	}
+	if cfg.Generate.Bson.Enabled {
 		execTemplate("bson.tmpl", "marshal_bson.go")
+		if cfg.Generate.Test && !cfg.Generate.Bson.Has("mgo") {
+			execTemplate("bson.test.tmpl", "marshal_bson_map_key_test.go")
+		}
 	}
-	if cfg.Generate.Json {
+	if cfg.Generate.BsonCodec {
+		execTemplate("bson.codec.tmpl", "marshal_bson_codec.go")
+
+		data.Codecs = packageCodecs(fset, pkgs[0].Syntax, cfg.EnumName)
+		ExecuteTemplate(templates, "bson.codecs.tmpl", path.Join(dir, "enum_marshal_bson_codecs.go"), data)
+	}
+	if cfg.Generate.Json.Enabled {
 		execTemplate("json.tmpl", "marshal_json.go")
+		if cfg.Generate.Test {
+			execTemplate("json.test.tmpl", "marshal_json_map_key_test.go")
+		}
 	}
-	if cfg.Generate.Xml {
+	if cfg.Generate.Xml.Enabled {
 		execTemplate("xml.tmpl", "marshal_xml.go")
 	}
-	if cfg.Generate.Sql || cfg.Generate.Ent {
+	if cfg.Generate.Yaml.Enabled {
+		execTemplate("yaml.tmpl", "marshal_yaml.go")
+	}
+	if cfg.Generate.Toml.Enabled {
+		execTemplate("toml.tmpl", "marshal_toml.go")
+	}
+	if cfg.Generate.Msgpack.Enabled {
+		execTemplate("msgpack.tmpl", "marshal_msgpack.go")
+	}
+	if cfg.Generate.Cbor.Enabled {
+		execTemplate("cbor.tmpl", "marshal_cbor.go")
+	}
+	if cfg.Generate.Binary.Enabled {
+		validateBinary(cfg, underlyingType, enumValues)
+		execTemplate("binary.tmpl", "marshal_binary.go")
+	}
+	if cfg.Generate.Decode {
+		execTemplate("decode.tmpl", "marshal_decode.go")
+	}
+	if cfg.Generate.Sql.Enabled || cfg.Generate.Gorm {
 		execTemplate("sql.tmpl", "marshal_sql.go")
 	}
-	if cfg.Generate.Text {
+	if cfg.Generate.Pgx {
+		if kind := values.BaseKind(underlyingType); cfg.Generate.Sql.UseValue() && kind != "int" && kind != "uint" && kind != "string" {
+			panic(fmt.Sprintf("-pgx does not support -sql=value for the underlying type %s", underlyingType))
+		}
+		execTemplate("pgx.tmpl", "marshal_pgx.go")
+	}
+	if cfg.Generate.Gorm {
+		execTemplate("gorm.tmpl", "marshal_gorm.go")
+	}
+	if cfg.Generate.Sqlc {
+		execTemplate("sqlc.yaml.tmpl", "sqlc.yaml")
+	}
+	if cfg.Generate.SqlDdl != "" {
+		data.Ddl = sqlDefinition(cfg, enumValues)
+		ddlPath := fullPath(dir, cfg.FileName, cfg.EnumName, fmt.Sprint(cfg.Generate.SqlDdl, ".sql"))
+
+		switch cfg.Generate.SqlDdl {
+		case "postgres":
+			data.Ddl.Migration = ddl.Diff(ddl.ReadValues(ddlPath), data.Ddl.Values)
+			execTemplate("sql.postgres.tmpl", "postgres.sql")
+		case "mysql":
+			data.Ddl.Migration = ddl.Diff(ddl.ReadValues(ddlPath), data.Ddl.Values)
+			execTemplate("sql.mysql.tmpl", "mysql.sql")
+		default:
+			panic(fmt.Sprintf("unknown sql-ddl: %s", cfg.Generate.SqlDdl))
+		}
+
+		// Migrations are numbered, so the migrations of previous runs, which
+		// may not have been applied yet, are never overwritten.
+		if !data.Ddl.Migration.IsEmpty() {
+			migration := nextPath(fullPath(dir, cfg.FileName, cfg.EnumName, fmt.Sprint(cfg.Generate.SqlDdl, "_migration.sql")))
+			ExecuteTemplate(templates, fmt.Sprintf("sql.%s.migration.tmpl", cfg.Generate.SqlDdl), migration, data)
+		}
+
+		for i := range data.Ddl.Migration.Added {
+			log.Printf("%s: added '%s' to %s", cfg.Generate.SqlDdl, data.Ddl.Migration.Added[i].Value, data.Ddl.Name)
+		}
+		for i := range data.Ddl.Migration.Removed {
+			log.Printf("warning: %s: removed '%s' from %s, existing rows may still use it", cfg.Generate.SqlDdl, data.Ddl.Migration.Removed[i], data.Ddl.Name)
+		}
+	}
+	if cfg.Generate.Avro {
+		data.Avro = avroSchema(cfg, enumValues, enumDefault)
+		if err := data.Avro.Validate(); err != nil {
+			panic(err)
+		}
+
+		execTemplate("avro.avsc.tmpl", ".avsc")
+	}
+	// Map keys are marshaled through encoding.TextMarshaler by both
+	// encoding/json and the mongo-driver.
+	if cfg.Generate.Text || cfg.Generate.Json.Enabled || cfg.Generate.Bson.Enabled || cfg.Generate.Toml.Enabled {
 		execTemplate("text.tmpl", "marshal_text.go")
 	}
-	if cfg.Generate.Ent {
+	if cfg.Generate.Flag.Enabled {
+		execTemplate("flag.tmpl", "marshal_flag.go")
+	}
+	if cfg.Generate.Ent.Enabled {
+		validateEnt(cfg, underlyingType, enumValues)
 		execTemplate("ent.tmpl", "marshal_ent.go")
 	}
 	switch cfg.Generate.Gql {
 	case "go":
 		execTemplate("gql.go.tmpl", "marshal_gql.go")
 	case "gql":
-		execTemplate("gql.graphql.tmpl", ".graphql")
+		execGqlSchema(templates, dir, cfg, data)
 	case "full":
 		execTemplate("gql.go.tmpl", "marshal_gql.go")
-		execTemplate("gql.graphql.tmpl", ".graphql")
+		execGqlSchema(templates, dir, cfg, data)
+	}
+}
+
+// execGqlSchema writes the graphql enum next to the source, or into the
+// configured schema directory as <package>_<enum>.graphql.
+func execGqlSchema(templates *template.Template, dir string, cfg *config.Config, data TemplateData) {
+	data.GqlDirective = gqlDirective(cfg.Generate.GqlDirective, fmt.Sprint(data.PkgPath, ".", data.EnumName))
+
+	if cfg.Generate.GqlSchemaDir == "" {
+		ExecuteTemplate(templates, "gql.graphql.tmpl", fullPath(dir, cfg.FileName, cfg.EnumName, ".graphql"), data)
+		return
+	}
+
+	schemaDir := cfg.Generate.GqlSchemaDir
+	if !filepath.IsAbs(schemaDir) {
+		schemaDir = filepath.Join(dir, schemaDir)
+	}
+
+	if err := os.MkdirAll(schemaDir, 0o755); err != nil {
+		panic(err)
+	}
+
+	fileName := fmt.Sprint(coerce.SnakeCase(data.Pkg), "_", coerce.SnakeCase(data.EnumName), ".graphql")
+	ExecuteTemplate(templates, "gql.graphql.tmpl", filepath.Join(schemaDir, fileName), data)
+}
+
+// gqlDirective returns the directive binding the graphql enum to its go model,
+// a custom directive has every {model} replaced by the path of the model.
+func gqlDirective(directive string, model string) string {
+	switch directive {
+	case "none":
+		return ""
+	case "gomodel":
+		return fmt.Sprintf("@goModel(model: %q)", model)
+	default:
+		return strings.ReplaceAll(directive, "{model}", model)
 	}
 }
 
@@ -121,6 +295,169 @@ func fullPath(dir string, fileName string, enumName string, suffix string) strin
 	return path.Join(dir, coerce.SnakeCase(suf))
 }
 
+// packageCodecs lists the bson codecs that go-enum generated for the package,
+// including the codec of the enum that is currently being generated.
+func packageCodecs(fset *token.FileSet, files []*ast.File, enumName string) []string {
+	codecs := []string{fmt.Sprint(enumName, "Codec")}
+	for i := range files {
+		if !strings.HasSuffix(fset.File(files[i].Pos()).Name(), "_enum_marshal_bson_codec.go") {
+			continue
+		}
+
+		funcDecls := util.Only[*ast.FuncDecl](files[i].Decls)
+		for j := range funcDecls {
+			name := funcDecls[j].Name.Name
+			if funcDecls[j].Recv != nil || !strings.HasPrefix(name, "Register") || !strings.HasSuffix(name, "Codec") {
+				continue
+			}
+
+			if codec := strings.TrimPrefix(name, "Register"); !util.Contains(codecs, codec) {
+				codecs = append(codecs, codec)
+			}
+		}
+	}
+
+	sort.Strings(codecs)
+	return codecs
+}
+
+// validateEnt checks the enum against the expectations of ent's schema/field
+// package, which would otherwise only fail once the ent schema is loaded.
+func validateEnt(cfg *config.Config, underlyingType string, enumValues []values.EnumValue) {
+	if cfg.Generate.Ent.UseValue() {
+		values.EntField(underlyingType)
+		return
+	}
+
+	if cfg.Generate.NoStringer {
+		return
+	}
+
+	names := map[string]string{}
+	for i := range enumValues {
+		if util.Contains(enumValues[i].Options, string(options.InvalidOption)) {
+			continue
+		}
+
+		name := stringer(enumValues[i].Name)
+		if name == "" {
+			panic(fmt.Sprintf("ent: %s has an empty name", enumValues[i].Name))
+		}
+		if other, ok := names[name]; ok {
+			panic(fmt.Sprintf("ent: %s and %s have the same name: %s", other, enumValues[i].Name, name))
+		}
+		names[name] = enumValues[i].Name
+	}
+}
+
+// sqlDefinition lists the values the database stores for the enum, as the
+// stringer names of the valid enum values.
+func sqlDefinition(cfg *config.Config, enumValues []values.EnumValue) ddl.Definition {
+	if cfg.Generate.Sql.UseValue() {
+		panic("-sql-ddl can not be combined with -sql=value")
+	}
+	if cfg.Generate.NoStringer {
+		panic("-sql-ddl can not be combined with -no-stringer")
+	}
+
+	definition := ddl.Definition{Name: coerce.SnakeCase(cfg.EnumName)}
+	for i := range enumValues {
+		if !util.Contains(enumValues[i].Options, string(options.InvalidOption)) {
+			definition.Values = append(definition.Values, stringer(enumValues[i].Name))
+		}
+	}
+	return definition
+}
+
+func validateOpen(cfg *config.Config) {
+	if cfg.Generate.NoStringer {
+		panic("-open can not be combined with -no-stringer")
+	}
+
+	// The open wrapper marshals names, unknown strings have no underlying
+	// value.
+	if cfg.Generate.Json.UseValue() || cfg.Generate.Bson.UseValue() || cfg.Generate.Xml.UseValue() || cfg.Generate.Yaml.UseValue() {
+		panic("-open can not be combined with the value representation")
+	}
+}
+
+func validatePredicates(cfg *config.Config, data TemplateData) {
+	methods := map[string]string{
+		"IsValid":   "-predicates",
+		"IsDefault": "-predicates",
+		"IsZero":    "-predicates",
+	}
+	for _, group := range data.Groups {
+		methods["Is"+coerce.PascalCase(group.Name)] = fmt.Sprintf("the %s group", group.Name)
+	}
+	if len(data.Machine.States) > 0 {
+		methods["IsTerminal"] = "//enum:to"
+	}
+
+	for i := range data.EnumValues {
+		name := predicate(data.EnumValues[i].Name)
+		if source, ok := methods[name]; ok {
+			panic(fmt.Sprintf("the predicate %s of %s clashes with %s", name, data.EnumValues[i].Name, source))
+		}
+		methods[name] = data.EnumValues[i].Name
+	}
+}
+
+func validateBinary(cfg *config.Config, underlyingType string, enumValues []values.EnumValue) {
+	if kind := values.BaseKind(underlyingType); cfg.Generate.Binary.UseValue() && kind != "int" && kind != "uint" && kind != "string" {
+		panic(fmt.Sprintf("-binary=value does not support the underlying type %s", underlyingType))
+	}
+
+	if !cfg.Generate.Binary.Has("id") {
+		return
+	}
+
+	ids := map[uint64]string{}
+	for i := range enumValues {
+		if util.Contains(enumValues[i].Options, string(options.InvalidOption)) {
+			continue
+		}
+
+		value, ok := options.Value(enumValues[i].Options, options.IdOption)
+		if !ok {
+			panic(fmt.Sprintf("binary: %s has no //enum:id=N", enumValues[i].Name))
+		}
+
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || strconv.FormatUint(id, 10) != value {
+			panic(fmt.Sprintf("binary: %s has an invalid id: %s", enumValues[i].Name, value))
+		}
+		if other, ok := ids[id]; ok {
+			panic(fmt.Sprintf("binary: %s and %s have the same id: %d", other, enumValues[i].Name, id))
+		}
+		ids[id] = enumValues[i].Name
+	}
+}
+
+// avroSchema lists the stringer names of the valid enum values as the symbols
+// of the schema, the default is only used when it is one of them.
+func avroSchema(cfg *config.Config, enumValues []values.EnumValue, enumDefault string) avro.Schema {
+	if cfg.Generate.NoStringer {
+		panic("-avro can not be combined with -no-stringer")
+	}
+
+	schema := avro.Schema{Name: cfg.EnumName}
+	for i := range enumValues {
+		if util.Contains(enumValues[i].Options, string(options.InvalidOption)) {
+			if enumValues[i].Name == enumDefault {
+				log.Printf("warning: avro: the default %s is invalid, the schema has no default", enumDefault)
+			}
+			continue
+		}
+
+		schema.Symbols = append(schema.Symbols, stringer(enumValues[i].Name))
+		if enumValues[i].Name == enumDefault {
+			schema.Default = stringer(enumValues[i].Name)
+		}
+	}
+	return schema
+}
+
 func ExecuteTemplate(tmpl *template.Template, name string, path string, data TemplateData) {
 	writer, err := os.Create(path)
 	if err != nil {
@@ -134,6 +471,18 @@ func ExecuteTemplate(tmpl *template.Template, name string, path string, data Tem
 	}
 }
 
+// nextPath returns the first path of the sequence name_001.ext, name_002.ext,
+// ... that does not exist yet.
+func nextPath(path string) string {
+	ext := filepath.Ext(path)
+	for i := 1; ; i++ {
+		next := fmt.Sprintf("%s_%03d%s", strings.TrimSuffix(path, ext), i, ext)
+		if _, err := os.Stat(next); os.IsNotExist(err) {
+			return next
+		}
+	}
+}
+
 func stringer(s string) string {
 	cfg := config.Instance()
 
@@ -157,6 +506,15 @@ func stringer(s string) string {
 	panic(fmt.Sprintf("unknown stringerCase: %s", cfg.StringerCase))
 }
 
+// predicate returns the name of the Is method of an enum value, without the
+// prefix.
+func predicate(s string) string {
+	cfg := config.Instance()
+
+	s = strings.TrimPrefix(coerce.SnakeCase(s), fmt.Sprint(coerce.SnakeCase(cfg.Prefix), "_"))
+	return "Is" + coerce.PascalCase(s)
+}
+
 func stringerFn() string {
 	cfg := config.Instance()
 
@@ -186,12 +544,22 @@ var TemplateFunctions = template.FuncMap{
 	"containsString": util.Contains[string],
 	"lower":          strings.ToLower,
 	"camel":          coerce.CamelCase,
+	"snake":          coerce.SnakeCase,
 	"pascal":         coerce.PascalCase,
 	"upperSnake":     coerce.UpperSnakeCase,
 	"plural":         pluralize.NewClient().Plural,
 	"stringer":       stringer,
 	"stringerFn":     stringerFn,
+	"predicate":      predicate,
 	"receiver":       receiver,
+	"baseKind":       values.BaseKind,
+	"driverType":     values.DriverType,
+	"entField":       values.EntField,
+	"zero":           values.Zero,
+	"valid":          values.Valid,
+	"parser":         values.Parser,
+	"sqlQuote":       ddl.Quote,
+	"sqlIdent":       ddl.QuoteIdent,
 }
 
 type TemplateData struct {
@@ -205,5 +573,11 @@ type TemplateData struct {
 	Json             bool
 	Xml              bool
 	EnumValues       []values.EnumValue
+	Codecs           []string
+	Ddl              ddl.Definition
+	GqlDirective     string
+	Avro             avro.Schema
+	Groups           []values.Group
+	Machine          values.Machine
 	Config           *config.Config
 }